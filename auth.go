@@ -0,0 +1,388 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cloneRequest returns a clone of the provided *http.Request with a deep
+// copy of its headers. A RoundTripper must not modify the request it was
+// given, so the transports below only ever change the clone.
+func cloneRequest(req *http.Request) *http.Request {
+	req2 := new(http.Request)
+	*req2 = *req
+	req2.Header = make(http.Header, len(req.Header))
+	for k, s := range req.Header {
+		req2.Header[k] = append([]string(nil), s...)
+	}
+	return req2
+}
+
+func transport(t http.RoundTripper) http.RoundTripper {
+	if t != nil {
+		return t
+	}
+	return http.DefaultTransport
+}
+
+// BearerAuthTransport is an http.RoundTripper that authenticates all requests
+// using a personal access token (PAT) sent as a Bearer token.
+type BearerAuthTransport struct {
+	Transport http.RoundTripper
+	Token     string
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *BearerAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req2 := cloneRequest(req)
+	req2.Header.Set("Authorization", "Bearer "+t.Token)
+
+	return transport(t.Transport).RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using a personal access token.
+func (t *BearerAuthTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// SessionAuthTransport is an http.RoundTripper that authenticates all requests
+// using a Jira session cookie. The session is created on the first request
+// and created again whenever Jira answers with 401 Unauthorized.
+type SessionAuthTransport struct {
+	Transport http.RoundTripper
+	// SiteURL is the root of the Jira site, e.g. https://jira.mycompany.com/.
+	// The session is created through {SiteURL}/rest/auth/1/session.
+	SiteURL  string
+	Username string
+	Password string
+
+	mu     sync.Mutex
+	cookie *http.Cookie
+}
+
+type sessionInfo struct {
+	Session struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"session"`
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *SessionAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	cookie, err := t.session(req.Context(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	req2 := cloneRequest(req)
+	req2.AddCookie(cookie)
+
+	resp, err := transport(t.Transport).RoundTrip(req2)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The session has probably expired, the request is sent again
+	// with a new session when its body can be replayed.
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	cookie, err = t.session(req.Context(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	req3 := cloneRequest(req)
+	if req.GetBody != nil {
+		if req3.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	req3.AddCookie(cookie)
+
+	return transport(t.Transport).RoundTrip(req3)
+}
+
+func (t *SessionAuthTransport) session(ctx context.Context, renew bool) (*http.Cookie, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.cookie != nil && !renew {
+		return t.cookie, nil
+	}
+
+	site := t.SiteURL
+	if !strings.HasSuffix(site, "/") {
+		site += "/"
+	}
+
+	body, err := json.Marshal(map[string]string{"username": t.Username, "password": t.Password})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", site+"rest/auth/1/session", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := transport(t.Transport).RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jira: unable to create session, %s", resp.Status)
+	}
+
+	info := &sessionInfo{}
+	if err := json.NewDecoder(resp.Body).Decode(info); err != nil {
+		return nil, err
+	}
+
+	t.cookie = &http.Cookie{Name: info.Session.Name, Value: info.Session.Value}
+	return t.cookie, nil
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using a Jira session cookie.
+func (t *SessionAuthTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// OAuth1Transport is an http.RoundTripper that signs all requests using
+// OAuth 1.0a with the RSA-SHA1 signature method, as expected by Jira
+// application links.
+type OAuth1Transport struct {
+	Transport   http.RoundTripper
+	ConsumerKey string
+	AccessToken string
+	PrivateKey  *rsa.PrivateKey
+
+	// now and nonce are replaced by tests to get a predictable signature.
+	now   func() time.Time
+	nonce func() string
+}
+
+// ParseRSAPrivateKey parses a PEM encoded RSA private key in PKCS #1 or PKCS #8 form.
+func ParseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("jira: no PEM data found in private key")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("jira: private key is not a RSA key")
+	}
+	return rsaKey, nil
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *OAuth1Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.PrivateKey == nil {
+		return nil, errors.New("jira: OAuth1Transport requires a private key")
+	}
+
+	now := time.Now
+	if t.now != nil {
+		now = t.now
+	}
+	nonce := randomNonce
+	if t.nonce != nil {
+		nonce = t.nonce
+	}
+
+	params := map[string]string{
+		"oauth_consumer_key":     t.ConsumerKey,
+		"oauth_nonce":            nonce(),
+		"oauth_signature_method": "RSA-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(now().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+	if t.AccessToken != "" {
+		params["oauth_token"] = t.AccessToken
+	}
+
+	signature, err := t.sign(req, params)
+	if err != nil {
+		return nil, err
+	}
+	params["oauth_signature"] = signature
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	header := make([]string, 0, len(keys))
+	for _, k := range keys {
+		header = append(header, fmt.Sprintf(`%s="%s"`, k, oauthEscape(params[k])))
+	}
+
+	req2 := cloneRequest(req)
+	req2.Header.Set("Authorization", "OAuth "+strings.Join(header, ", "))
+
+	return transport(t.Transport).RoundTrip(req2)
+}
+
+func (t *OAuth1Transport) sign(req *http.Request, oauthParams map[string]string) (string, error) {
+	var pairs []string
+	for k, v := range oauthParams {
+		pairs = append(pairs, oauthEscape(k)+"="+oauthEscape(v))
+	}
+	for k, vs := range req.URL.Query() {
+		for _, v := range vs {
+			pairs = append(pairs, oauthEscape(k)+"="+oauthEscape(v))
+		}
+	}
+	sort.Strings(pairs)
+
+	u := *req.URL
+	u.RawQuery = ""
+	u.Fragment = ""
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+
+	base := strings.Join([]string{
+		oauthEscape(strings.ToUpper(req.Method)),
+		oauthEscape(u.String()),
+		oauthEscape(strings.Join(pairs, "&")),
+	}, "&")
+
+	h := sha1.Sum([]byte(base))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, t.PrivateKey, crypto.SHA1, h[:])
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// Client returns an *http.Client that makes requests that are signed using OAuth 1.0a.
+func (t *OAuth1Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// oauthEscape percent encodes a string as defined by RFC 5849, section 3.6.
+func oauthEscape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func randomNonce() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// RateLimitTransport is an http.RoundTripper that limits the number of
+// requests sent per second. Requests wait for their turn, or until their
+// context is done.
+type RateLimitTransport struct {
+	Transport http.RoundTripper
+	// Rate is the number of requests allowed per second.
+	Rate float64
+	// Burst is the number of requests that can be sent at once, default: 1.
+	Burst int
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if delay := t.reserve(time.Now()); delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	return transport(t.Transport).RoundTrip(req)
+}
+
+// reserve takes a token from the bucket and returns how long the caller
+// must wait before the token becomes available.
+func (t *RateLimitTransport) reserve(now time.Time) time.Duration {
+	if t.Rate <= 0 {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	burst := float64(t.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	if t.last.IsZero() {
+		t.tokens = burst
+		t.last = now
+	} else if now.After(t.last) {
+		t.tokens += now.Sub(t.last).Seconds() * t.Rate
+		if t.tokens > burst {
+			t.tokens = burst
+		}
+		t.last = now
+	}
+
+	t.tokens--
+	if t.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-t.tokens / t.Rate * float64(time.Second))
+}
+
+// Client returns an *http.Client that limits the number of requests per second.
+func (t *RateLimitTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
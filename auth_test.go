@@ -0,0 +1,174 @@
+package jira
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBearerAuthTransport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer t0k3n", r.Header.Get("Authorization"))
+	})
+
+	tp := &BearerAuthTransport{Token: "t0k3n"}
+	bac, _ := NewClient(defaultBaseURL, tp.Client())
+	bac.BaseURL = client.BaseURL
+	req, _ := bac.NewRequest("GET", ".", nil)
+	_, err := bac.Do(context.Background(), req, nil)
+	assert.Nil(t, err)
+}
+
+func TestSessionAuthTransport(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	logins := 0
+	mux.HandleFunc("/rest/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		logins++
+		fmt.Fprintf(w, `{"session": {"name": "JSESSIONID", "value": "s%d"}}`, logins)
+	})
+
+	calls := 0
+	mux.HandleFunc("/board", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		c, err := r.Cookie("JSESSIONID")
+		assert.Nil(t, err)
+		// the first session expires right away
+		if c.Value == "s1" && calls > 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	tp := &SessionAuthTransport{SiteURL: serverURL + baseURLPath, Username: "u", Password: "p"}
+	sc, _ := NewClient(defaultBaseURL, tp.Client())
+	sc.BaseURL = client.BaseURL
+
+	for i := 0; i < 2; i++ {
+		req, _ := sc.NewRequest("GET", "board", nil)
+		resp, err := sc.Do(context.Background(), req, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	assert.Equal(t, 2, logins)
+	assert.Equal(t, 3, calls)
+}
+
+func TestOAuth1Transport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	assert.Nil(t, err)
+
+	mux.HandleFunc("/board", func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		assert.True(t, strings.HasPrefix(auth, "OAuth "))
+		assert.Contains(t, auth, `oauth_consumer_key="consumer"`)
+		assert.Contains(t, auth, `oauth_token="token"`)
+		assert.Contains(t, auth, `oauth_nonce="n0nc3"`)
+		assert.Contains(t, auth, `oauth_timestamp="1546300800"`)
+		assert.Contains(t, auth, `oauth_signature_method="RSA-SHA1"`)
+
+		var signature string
+		for _, p := range strings.Split(strings.TrimPrefix(auth, "OAuth "), ", ") {
+			if strings.HasPrefix(p, "oauth_signature=") {
+				signature = strings.Trim(strings.TrimPrefix(p, "oauth_signature="), `"`)
+			}
+		}
+
+		u := "http://" + r.Host + baseURLPath + "/board"
+		base := "GET&" + oauthEscape(u) + "&" + oauthEscape("maxResults=10&"+
+			"oauth_consumer_key=consumer&oauth_nonce=n0nc3&oauth_signature_method=RSA-SHA1&"+
+			"oauth_timestamp=1546300800&oauth_token=token&oauth_version=1.0")
+		signature, err := url.PathUnescape(signature)
+		assert.Nil(t, err)
+		sig, err := base64.StdEncoding.DecodeString(signature)
+		assert.Nil(t, err)
+
+		h := sha1.Sum([]byte(base))
+		assert.Nil(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA1, h[:], sig))
+	})
+
+	tp := &OAuth1Transport{
+		ConsumerKey: "consumer",
+		AccessToken: "token",
+		PrivateKey:  key,
+		now:         func() time.Time { return time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC) },
+		nonce:       func() string { return "n0nc3" },
+	}
+	oc, _ := NewClient(defaultBaseURL, tp.Client())
+	oc.BaseURL = client.BaseURL
+
+	req, _ := oc.NewRequest("GET", "board?maxResults=10", nil)
+	_, err = oc.Do(context.Background(), req, nil)
+	assert.Nil(t, err)
+}
+
+func TestParseRSAPrivateKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	assert.Nil(t, err)
+
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	parsed, err := ParseRSAPrivateKey(pkcs1)
+	assert.Nil(t, err)
+	assert.Equal(t, key.N, parsed.N)
+
+	der, _ := x509.MarshalPKCS8PrivateKey(key)
+	pkcs8 := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	parsed, err = ParseRSAPrivateKey(pkcs8)
+	assert.Nil(t, err)
+	assert.Equal(t, key.N, parsed.N)
+
+	_, err = ParseRSAPrivateKey([]byte("foo"))
+	assert.NotNil(t, err)
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	tp := &RateLimitTransport{Rate: 2, Burst: 2}
+	now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Duration(0), tp.reserve(now))
+	assert.Equal(t, time.Duration(0), tp.reserve(now))
+	assert.Equal(t, 500*time.Millisecond, tp.reserve(now))
+	assert.Equal(t, 500*time.Millisecond, tp.reserve(now.Add(500*time.Millisecond)))
+
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {})
+
+	limited := &RateLimitTransport{Rate: 1}
+	rc, _ := NewClient(defaultBaseURL, limited.Client())
+	rc.BaseURL = client.BaseURL
+
+	req, _ := rc.NewRequest("GET", ".", nil)
+	_, err := rc.Do(context.Background(), req, nil)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ = rc.NewRequest("GET", ".", nil)
+	_, err = rc.Do(ctx, req, nil)
+	assert.Equal(t, context.Canceled, err)
+}
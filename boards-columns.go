@@ -0,0 +1,196 @@
+package jira
+
+import (
+	"context"
+	"strings"
+)
+
+// BoardColumn represents a column of a board with the metadata of its statuses.
+// Minimum and Maximum are the WIP limits of the column, 0 when not defined.
+type BoardColumn struct {
+	Name     string
+	Minimum  int
+	Maximum  int
+	Statuses []*IssueStatus
+}
+
+// BoardColumns contains the configuration of a board with its columns resolved, so
+// the column and the status category of any status can be found
+type BoardColumns struct {
+	Configuration *Configuration
+	Columns       []*BoardColumn
+
+	byStatus map[string]*BoardColumn
+	statuses map[string]*IssueStatus
+}
+
+// NewBoardColumns resolves the columns of the board configuration with the metadata of
+// the statuses (see StatusesService.List). Statuses without metadata only have an id.
+func NewBoardColumns(config *Configuration, statuses []*IssueStatus) *BoardColumns {
+	c := &BoardColumns{
+		Configuration: config,
+		byStatus:      map[string]*BoardColumn{},
+		statuses:      map[string]*IssueStatus{},
+	}
+
+	for _, s := range statuses {
+		c.statuses[s.ID] = s
+	}
+
+	for _, col := range config.ColumnConfiguration.Columns {
+		column := &BoardColumn{Name: col.Name, Minimum: col.Minimum, Maximum: col.Maximum}
+		for _, cs := range col.Statuses {
+			status, ok := c.statuses[cs.ID]
+			if !ok {
+				status = &IssueStatus{ID: cs.ID, SelfLink: cs.SelfLink}
+			}
+			column.Statuses = append(column.Statuses, status)
+			c.byStatus[cs.ID] = column
+		}
+		c.Columns = append(c.Columns, column)
+	}
+
+	return c
+}
+
+// Column returns the column of the board where the issues with the status are shown, nil
+// when the status is not mapped to any column. Statuses are matched by id and, when they
+// have no id, by name.
+func (c *BoardColumns) Column(status *IssueStatus) *BoardColumn {
+	if status == nil {
+		return nil
+	}
+
+	if status.ID != "" {
+		return c.byStatus[status.ID]
+	}
+
+	for _, column := range c.Columns {
+		for _, s := range column.Statuses {
+			if s.Name != "" && strings.EqualFold(s.Name, status.Name) {
+				return column
+			}
+		}
+	}
+
+	return nil
+}
+
+// Category returns the status category of the status, from the status metadata or,
+// when it is not known, from the status itself
+func (c *BoardColumns) Category(status *IssueStatus) *IssueStatusCategory {
+	if status == nil {
+		return nil
+	}
+
+	if s, ok := c.statuses[status.ID]; ok && s.Category != nil {
+		return s.Category
+	}
+
+	return status.Category
+}
+
+// GetColumns returns the configuration of the board with its columns resolved with
+// the metadata of the statuses.
+//
+// GET /rest/agile/1.0/board/{boardId}/configuration
+// GET /rest/api/2/status
+func (b *BoardsService) GetColumns(ctx context.Context, boardID int) (*BoardColumns, *Response, error) {
+
+	config, resp, err := b.GetConfiguration(ctx, boardID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	statuses, resp, err := b.client.Statuses.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return NewBoardColumns(config, statuses), resp, nil
+}
+
+// ColumnIssues contains the issues shown in a column of a board
+type ColumnIssues struct {
+	Column *BoardColumn
+	Issues []*Issue
+}
+
+// BoardSnapshot contains the issues of a board grouped by column, in the order of the
+// columns. Issues whose status is not mapped to any column are not shown on the board,
+// they are kept apart.
+type BoardSnapshot struct {
+	BoardColumns *BoardColumns
+	Columns      []*ColumnIssues
+	Unmapped     []*Issue
+}
+
+// NewBoardSnapshot groups the issues into the columns of the board
+func NewBoardSnapshot(columns *BoardColumns, issues []*Issue) *BoardSnapshot {
+	s := &BoardSnapshot{BoardColumns: columns}
+
+	index := map[*BoardColumn]*ColumnIssues{}
+	for _, column := range columns.Columns {
+		ci := &ColumnIssues{Column: column}
+		index[column] = ci
+		s.Columns = append(s.Columns, ci)
+	}
+
+	for _, issue := range issues {
+		var status *IssueStatus
+		if issue.Fields != nil {
+			status = issue.Fields.Status
+		}
+
+		if column := columns.Column(status); column != nil {
+			index[column].Issues = append(index[column].Issues, issue)
+		} else {
+			s.Unmapped = append(s.Unmapped, issue)
+		}
+	}
+
+	return s
+}
+
+// Snapshot returns the issues of the board grouped by column. All pages of issues are
+// read, starting at the page defined by opts. The status field is always requested.
+//
+// GET /rest/agile/1.0/board/{boardId}/configuration
+// GET /rest/api/2/status
+// GET /rest/agile/1.0/board/{boardId}/issue
+func (b *BoardsService) Snapshot(ctx context.Context, boardID int, opts *IssuesOptions) (*BoardSnapshot, *Response, error) {
+
+	columns, resp, err := b.GetColumns(ctx, boardID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	o := IssuesOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Fields != "" && !hasField(o.Fields, "status") {
+		o.Fields += ",status"
+	}
+
+	list := func(ctx context.Context, opts *IssuesOptions) ([]*Issue, *Response, error) {
+		return b.ListIssues(ctx, boardID, opts)
+	}
+
+	issues, resp, err := listAllIssues(ctx, &o, list)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return NewBoardSnapshot(columns, issues), resp, nil
+}
+
+// hasField reports whether the comma-separated list of fields contains the field
+func hasField(fields, field string) bool {
+	for _, f := range strings.Split(fields, ",") {
+		if strings.TrimSpace(f) == field || strings.TrimSpace(f) == "*all" {
+			return true
+		}
+	}
+	return false
+}
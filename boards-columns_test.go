@@ -0,0 +1,94 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func handleBoardColumns(mux *http.ServeMux) {
+	mux.HandleFunc("/board/84/configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 84,"name": "Kanban",
+			"location": {"type": "project","key": "MCP","id": "10000","name": "My Project"},
+			"subQuery": {"query": "fixVersion in unreleasedVersions() OR fixVersion is EMPTY"},
+			"columnConfig": {"constraintType": "issueCount","columns": [
+				{"name": "To Do","statuses": [{"id": "1"},{"id": "4"}]},
+				{"name": "In progress","statuses": [{"id": "3"}],"min": 1,"max": 2},
+				{"name": "Done","statuses": [{"id": "5"}]}]}}`)
+	})
+
+	mux.HandleFunc("/api/2/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "1","name": "Open","statusCategory": {"key": "new"}},
+			{"id": "3","name": "In Progress","statusCategory": {"key": "indeterminate"}},
+			{"id": "5","name": "Closed","statusCategory": {"key": "done"}}]`)
+	})
+}
+
+func TestBoardsServiceGetColumns(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleBoardColumns(mux)
+
+	columns, _, err := client.Boards.GetColumns(context.Background(), 84)
+	assert.Nil(t, err)
+
+	config := columns.Configuration
+	assert.Equal(t, "MCP", config.Location.Key)
+	assert.Equal(t, "project", config.Location.Type)
+	assert.Equal(t, "fixVersion in unreleasedVersions() OR fixVersion is EMPTY", config.SubQuery.Query)
+
+	assert.Len(t, columns.Columns, 3)
+	assert.Equal(t, "Open", columns.Columns[0].Statuses[0].Name)
+	// status 4 has no metadata
+	assert.Equal(t, &IssueStatus{ID: "4"}, columns.Columns[0].Statuses[1])
+
+	column := columns.Column(&IssueStatus{ID: "3"})
+	assert.Equal(t, "In progress", column.Name)
+	assert.Equal(t, 1, column.Minimum)
+	assert.Equal(t, 2, column.Maximum)
+
+	assert.Equal(t, "Done", columns.Column(&IssueStatus{Name: "closed"}).Name)
+	assert.Nil(t, columns.Column(&IssueStatus{ID: "99"}))
+	assert.Nil(t, columns.Column(nil))
+
+	assert.Equal(t, StatusCategoryInProgress, columns.Category(&IssueStatus{ID: "3"}).Key)
+	assert.Equal(t, "custom", columns.Category(&IssueStatus{ID: "99", Category: &IssueStatusCategory{Key: "custom"}}).Key)
+	assert.Nil(t, columns.Category(&IssueStatus{ID: "4"}))
+}
+
+func TestBoardsServiceSnapshot(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleBoardColumns(mux)
+
+	mux.HandleFunc("/board/84/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "summary,status", r.URL.Query().Get("fields"))
+		fmt.Fprint(w, `{"maxResults": 50,"issues": [
+			{"key": "MCP-1","fields": {"status": {"id": "3"}}},
+			{"key": "MCP-2","fields": {"status": {"id": "1"}}},
+			{"key": "MCP-3","fields": {"status": {"id": "4"}}},
+			{"key": "MCP-4","fields": {"status": {"id": "99"}}}]}`)
+	})
+
+	snapshot, _, err := client.Boards.Snapshot(context.Background(), 84, &IssuesOptions{Fields: "summary"})
+	assert.Nil(t, err)
+	assert.Len(t, snapshot.Columns, 3)
+
+	keys := func(issues []*Issue) []string {
+		var k []string
+		for _, issue := range issues {
+			k = append(k, issue.Key)
+		}
+		return k
+	}
+
+	assert.Equal(t, []string{"MCP-2", "MCP-3"}, keys(snapshot.Columns[0].Issues))
+	assert.Equal(t, []string{"MCP-1"}, keys(snapshot.Columns[1].Issues))
+	assert.Empty(t, snapshot.Columns[2].Issues)
+	assert.Equal(t, []string{"MCP-4"}, keys(snapshot.Unmapped))
+}
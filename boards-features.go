@@ -0,0 +1,100 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// Board features that can be toggled, e.g. to enable estimation, sprints
+// or backlog on kanban boards
+const (
+	BoardFeatureEstimation = "ESTIMATION"
+	BoardFeatureSprints    = "SPRINTS"
+	BoardFeatureBacklog    = "BACKLOG"
+)
+
+// BoardFeatureWrap represents the features data returned by the API
+type BoardFeatureWrap struct {
+	Features []*BoardFeature `json:"features,omitempty"`
+}
+
+// BoardFeature represents a Jira Agile Board Feature
+type BoardFeature struct {
+	BoardID                    int                           `json:"boardId,omitempty"`
+	Feature                    string                        `json:"boardFeature,omitempty"`
+	FeatureID                  string                        `json:"featureId,omitempty"`
+	FeatureType                string                        `json:"featureType,omitempty"`
+	State                      string                        `json:"state,omitempty"`
+	ToggleLocked               bool                          `json:"toggleLocked,omitempty"`
+	LocalisedName              string                        `json:"localisedName,omitempty"`
+	LocalisedDescription       string                        `json:"localisedDescription,omitempty"`
+	LocalisedGroup             string                        `json:"localisedGroup,omitempty"`
+	LearnMoreLink              string                        `json:"learnMoreLink,omitempty"`
+	ImageURI                   string                        `json:"imageUri,omitempty"`
+	PermissibleEstimationTypes []*BoardFeatureEstimationType `json:"permissibleEstimationTypes,omitempty"`
+}
+
+// Enabled reports whether the feature is enabled on the board
+func (f *BoardFeature) Enabled() bool {
+	return f.State == "ENABLED"
+}
+
+// BoardFeatureEstimationType represents an estimation type allowed by the estimation feature
+type BoardFeatureEstimationType struct {
+	Value         string `json:"value,omitempty"`
+	LocalisedName string `json:"localisedName,omitempty"`
+}
+
+// BoardFeatureToggle contains the fields to enable or disable a board feature
+type BoardFeatureToggle struct {
+	BoardID  int    `json:"boardId,omitempty"`
+	Feature  string `json:"feature,omitempty"`
+	Enabling bool   `json:"enabling"`
+}
+
+// ListFeatures returns the features of the board, for the given board Id, and whether
+// they are enabled or not.
+//
+// GET /rest/agile/1.0/board/{boardId}/features
+func (b *BoardsService) ListFeatures(ctx context.Context, boardID int) ([]*BoardFeature, *Response, error) {
+
+	req, err := b.client.NewRequest("GET", fmt.Sprintf("board/%d/features", boardID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &BoardFeatureWrap{}
+	resp, err := b.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wrap.Features, resp, nil
+}
+
+// ToggleFeature enables or disables a feature of the board, for the given board Id,
+// e.g. BoardFeatureEstimation, BoardFeatureSprints or BoardFeatureBacklog on kanban
+// boards. The features of the board are returned after the change.
+//
+// PUT /rest/agile/1.0/board/{boardId}/features
+func (b *BoardsService) ToggleFeature(ctx context.Context, boardID int, feature string, enabling bool) ([]*BoardFeature, *Response, error) {
+
+	toggle := &BoardFeatureToggle{
+		BoardID:  boardID,
+		Feature:  feature,
+		Enabling: enabling,
+	}
+
+	req, err := b.client.NewRequest("PUT", fmt.Sprintf("board/%d/features", boardID), toggle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &BoardFeatureWrap{}
+	resp, err := b.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wrap.Features, resp, nil
+}
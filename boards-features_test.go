@@ -0,0 +1,52 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const boardFeaturesAsJSON = `{"features": [
+	{"boardId": 84,"boardFeature": "ESTIMATION","featureId": "jsw.agility.estimation","state": "DISABLED","featureType": "BASIC",
+	 "localisedName": "Estimation","permissibleEstimationTypes": [{"value": "STORY_POINTS","localisedName": "Story points"}]},
+	{"boardId": 84,"boardFeature": "BACKLOG","featureId": "jsw.agility.backlog","state": "ENABLED","featureType": "BASIC","localisedName": "Backlog"}]}`
+
+func TestBoardsServiceListFeatures(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/84/features", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, boardFeaturesAsJSON)
+	})
+
+	features, _, err := client.Boards.ListFeatures(context.Background(), 84)
+	assert.Nil(t, err)
+	assert.Len(t, features, 2)
+
+	assert.Equal(t, BoardFeatureEstimation, features[0].Feature)
+	assert.False(t, features[0].Enabled())
+	assert.Equal(t, "STORY_POINTS", features[0].PermissibleEstimationTypes[0].Value)
+	assert.Equal(t, BoardFeatureBacklog, features[1].Feature)
+	assert.True(t, features[1].Enabled())
+}
+
+func TestBoardsServiceToggleFeature(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/84/features", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"boardId": 84,"feature": "BACKLOG","enabling": false}`, string(body))
+		fmt.Fprint(w, boardFeaturesAsJSON)
+	})
+
+	features, _, err := client.Boards.ToggleFeature(context.Background(), 84, BoardFeatureBacklog, false)
+	assert.Nil(t, err)
+	assert.Len(t, features, 2)
+}
@@ -0,0 +1,110 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ListPropertyKeys returns the keys of all properties for the board, for the given board Id.
+// The user who retrieves the property keys is required to have permissions to view the board.
+//
+// GET /rest/agile/1.0/board/{boardId}/properties
+func (b *BoardsService) ListPropertyKeys(ctx context.Context, boardID int) ([]*PropertyKey, *Response, error) {
+
+	req, err := b.client.NewRequest("GET", fmt.Sprintf("board/%d/properties", boardID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys = &PropertyKeys{}
+	resp, err := b.client.Do(ctx, req, keys)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keys.Keys, resp, nil
+}
+
+// GetProperty returns the value of the property with the given key from the board, for the
+// given board Id. The user who retrieves the property is required to have permissions to view
+// the board. A missing property returns an *ErrorResponse with the 404 status code.
+//
+// GET /rest/agile/1.0/board/{boardId}/properties/{propertyKey}
+func (b *BoardsService) GetProperty(ctx context.Context, boardID int, key string) (*Property, *Response, error) {
+
+	req, err := b.client.NewRequest("GET", fmt.Sprintf("board/%d/properties/%s", boardID, url.PathEscape(key)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var property = &Property{}
+	resp, err := b.client.Do(ctx, req, property)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return property, resp, nil
+}
+
+// GetPropertyValue returns the property with the given key from the board, decoding its
+// value into the value pointed to by v.
+//
+// GET /rest/agile/1.0/board/{boardId}/properties/{propertyKey}
+func (b *BoardsService) GetPropertyValue(ctx context.Context, boardID int, key string, v interface{}) (*Response, error) {
+
+	property, resp, err := b.GetProperty(ctx, boardID, key)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, property.Unmarshal(v)
+}
+
+// SetProperty sets the value of the specified board's property. The value is JSON encoded,
+// it can be any value that is valid JSON and its maximum length is 32768 bytes. The user who
+// sets the property is required to have permissions to administer the board.
+//
+// PUT /rest/agile/1.0/board/{boardId}/properties/{propertyKey}
+func (b *BoardsService) SetProperty(ctx context.Context, boardID int, key string, value interface{}) (bool, *Response, error) {
+
+	req, err := b.client.NewRequest("PUT", fmt.Sprintf("board/%d/properties/%s", boardID, url.PathEscape(key)), value)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := b.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// DeleteProperty removes the property from the board identified by the id. The user who removes
+// the property is required to have permissions to administer the board.
+//
+// DELETE /rest/agile/1.0/board/{boardId}/properties/{propertyKey}
+func (b *BoardsService) DeleteProperty(ctx context.Context, boardID int, key string) (bool, *Response, error) {
+
+	req, err := b.client.NewRequest("DELETE", fmt.Sprintf("board/%d/properties/%s", boardID, url.PathEscape(key)), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := b.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
@@ -0,0 +1,113 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type automationSettings struct {
+	AutoClose bool     `json:"autoClose"`
+	Labels    []string `json:"labels"`
+}
+
+func TestBoardsServiceListPropertyKeys(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5259/properties", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"keys": [{"self": "https://jira.mycompany.com/rest/agile/1.0/board/5259/properties/automation","key": "automation"}]}`)
+	})
+
+	keys, _, err := client.Boards.ListPropertyKeys(context.Background(), 5259)
+	assert.Nil(t, err)
+
+	want := []*PropertyKey{
+		{
+			Key:      "automation",
+			SelfLink: "https://jira.mycompany.com/rest/agile/1.0/board/5259/properties/automation",
+		},
+	}
+	assert.True(t, reflect.DeepEqual(keys, want))
+}
+
+func TestBoardsServiceGetProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5259/properties/automation", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"key": "automation","value": {"autoClose": true,"labels": ["bot"]}}`)
+	})
+
+	property, _, err := client.Boards.GetProperty(context.Background(), 5259, "automation")
+	assert.Nil(t, err)
+	assert.Equal(t, "automation", property.Key)
+	assert.JSONEq(t, `{"autoClose": true,"labels": ["bot"]}`, string(property.Value))
+
+	settings := &automationSettings{}
+	_, err = client.Boards.GetPropertyValue(context.Background(), 5259, "automation", settings)
+	assert.Nil(t, err)
+	assert.True(t, reflect.DeepEqual(settings, &automationSettings{AutoClose: true, Labels: []string{"bot"}}))
+}
+
+func TestBoardsServiceGetPropertyNotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5259/properties/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages": ["The property with key 'missing' does not exist."],"errors": {}}`)
+	})
+
+	property, resp, err := client.Boards.GetProperty(context.Background(), 5259, "missing")
+	assert.Nil(t, property)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, []string{"The property with key 'missing' does not exist."}, err.(*ErrorResponse).Messages)
+
+	settings := &automationSettings{}
+	_, err = client.Boards.GetPropertyValue(context.Background(), 5259, "missing", settings)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestBoardsServiceSetProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5259/properties/automation", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+
+		settings := &automationSettings{}
+		assert.Nil(t, json.Unmarshal(body, settings))
+		assert.True(t, settings.AutoClose)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ok, _, err := client.Boards.SetProperty(context.Background(), 5259, "automation", &automationSettings{AutoClose: true})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestBoardsServiceDeleteProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5259/properties/automation", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ok, _, err := client.Boards.DeleteProperty(context.Background(), 5259, "automation")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,77 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// QuickFilterWrap represents the data returned by the API,
+// in addition to the quick filters information, paging data is returned
+type QuickFilterWrap struct {
+	Pagination
+	Values []*QuickFilter `json:"values,omitempty"`
+}
+
+// QuickFilter represents a Jira Agile Board Quick Filter
+type QuickFilter struct {
+	ID          int    `json:"id,omitempty"`
+	BoardID     int    `json:"boardId,omitempty"`
+	Name        string `json:"name,omitempty"`
+	JQL         string `json:"jql,omitempty"`
+	Description string `json:"description,omitempty"`
+	Position    int    `json:"position,omitempty"`
+}
+
+// QuickFiltersOptions contains all options to list all quick filters from a board
+type QuickFiltersOptions struct {
+	//The starting index of the returned quick filters. Base index: 0. See the 'Pagination' section at the top of this page for more details.
+	StartAt int `query:"startAt"`
+	//The maximum number of quick filters to return per page. Default: 50. See the 'Pagination' section at the top of this page for more details.
+	MaxResults int `query:"maxResults"`
+}
+
+// ListQuickFilters returns all quick filters from a board, for a given board Id.
+// Quick filters are returned ordered by their position on the board.
+//
+// GET /rest/agile/1.0/board/{boardId}/quickfilter
+func (b *BoardsService) ListQuickFilters(ctx context.Context, boardID int, opts *QuickFiltersOptions) ([]*QuickFilter, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := b.client.NewRequest("GET", fmt.Sprintf("board/%d/quickfilter%s", boardID, q), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &QuickFilterWrap{}
+	resp, err := b.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.MaxResults = wrap.MaxResults
+	resp.StartAt = wrap.StartAt
+	resp.IsLast = wrap.IsLast
+
+	return wrap.Values, resp, nil
+}
+
+// GetQuickFilter returns the quick filter for a given quick filter Id. The quick filter
+// will only be returned if the user can view the board that the quick filter belongs to.
+//
+// GET /rest/agile/1.0/board/{boardId}/quickfilter/{quickFilterId}
+func (b *BoardsService) GetQuickFilter(ctx context.Context, boardID int, quickFilterID int) (*QuickFilter, *Response, error) {
+
+	req, err := b.client.NewRequest("GET", fmt.Sprintf("board/%d/quickfilter/%d", boardID, quickFilterID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var quickFilter = &QuickFilter{}
+	resp, err := b.client.Do(ctx, req, quickFilter)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return quickFilter, resp, nil
+}
@@ -0,0 +1,55 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoardsServiceListQuickFilters(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/84/quickfilter", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "1", r.URL.Query().Get("maxResults"))
+		fmt.Fprint(w, `{"maxResults": 1,"startAt": 0,"total": 2,"isLast": false,
+		"values": [{"id": 1,"boardId": 84,"name": "Bugs","jql": "issuetype = Bug","description": "Only bugs","position": 0}]}`)
+	})
+
+	quickFilters, resp, err := client.Boards.ListQuickFilters(context.Background(), 84, &QuickFiltersOptions{MaxResults: 1})
+	assert.Nil(t, err)
+
+	want := []*QuickFilter{
+		{
+			ID:          1,
+			BoardID:     84,
+			Name:        "Bugs",
+			JQL:         "issuetype = Bug",
+			Description: "Only bugs",
+		},
+	}
+	assert.True(t, reflect.DeepEqual(quickFilters, want))
+	assert.Equal(t, 1, resp.MaxResults)
+	assert.Equal(t, 0, resp.StartAt)
+	assert.False(t, resp.IsLast)
+}
+
+func TestBoardsServiceGetQuickFilter(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/84/quickfilter/2", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": 2,"boardId": 84,"name": "Mine","jql": "assignee = currentUser()","position": 1}`)
+	})
+
+	quickFilter, _, err := client.Boards.GetQuickFilter(context.Background(), 84, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, "assignee = currentUser()", quickFilter.JQL)
+	assert.Equal(t, 1, quickFilter.Position)
+}
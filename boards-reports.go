@@ -0,0 +1,38 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// BoardReportWrap represents the reports data returned by the API
+type BoardReportWrap struct {
+	Reports []*BoardReport `json:"reports,omitempty"`
+}
+
+// BoardReport represents a report available for a Jira Agile Board
+type BoardReport struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	SelfLink    string `json:"self,omitempty"`
+}
+
+// ListReports returns all reports available for the board, for the given board Id.
+//
+// GET /rest/agile/1.0/board/{boardId}/reports
+func (b *BoardsService) ListReports(ctx context.Context, boardID int) ([]*BoardReport, *Response, error) {
+
+	req, err := b.client.NewRequest("GET", fmt.Sprintf("board/%d/reports", boardID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &BoardReportWrap{}
+	resp, err := b.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wrap.Reports, resp, nil
+}
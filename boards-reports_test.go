@@ -0,0 +1,25 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoardsServiceListReports(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/84/reports", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"reports": [{"id": "burndown","name": "Burndown Chart"},{"id": "velocity","name": "Velocity Chart"}]}`)
+	})
+
+	reports, _, err := client.Boards.ListReports(context.Background(), 84)
+	assert.Nil(t, err)
+	assert.Len(t, reports, 2)
+	assert.Equal(t, "Velocity Chart", reports[1].Name)
+}
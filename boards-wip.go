@@ -0,0 +1,144 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Column constraint types of the boards
+const (
+	ConstraintNone               = "none"
+	ConstraintIssueCount         = "issueCount"
+	ConstraintIssueCountExclSubs = "issueCountExclSubs"
+)
+
+// Kinds of WIP limit violations
+const (
+	WIPBelowMinimum = "min"
+	WIPAboveMaximum = "max"
+)
+
+// WIPViolation represents a column whose number of issues breaks one of its WIP limits
+type WIPViolation struct {
+	Column *BoardColumn
+	//WIPBelowMinimum or WIPAboveMaximum.
+	Kind string
+	//The limit that is broken.
+	Limit int
+	//Number of issues counted in the column.
+	Count int
+	//The issues counted in the column.
+	Issues []*Issue
+}
+
+func (v *WIPViolation) String() string {
+	if v.Kind == WIPBelowMinimum {
+		return fmt.Sprintf("column %s has %d issues, minimum is %d", v.Column.Name, v.Count, v.Limit)
+	}
+	return fmt.Sprintf("column %s has %d issues, maximum is %d", v.Column.Name, v.Count, v.Limit)
+}
+
+// WIPReport contains the WIP limit violations of a board at a given time
+type WIPReport struct {
+	BoardID    int
+	CheckedAt  time.Time
+	Violations []*WIPViolation
+}
+
+// OK reports whether no column breaks its WIP limits
+func (r *WIPReport) OK() bool {
+	return len(r.Violations) == 0
+}
+
+// WIPViolations returns the columns of the snapshot whose number of issues breaks their
+// minimum or maximum. Issues are counted according to the constraint type of the board,
+// sub-tasks are not counted when it is ConstraintIssueCountExclSubs and nothing is
+// reported when it is ConstraintNone.
+func (s *BoardSnapshot) WIPViolations() []*WIPViolation {
+	constraint := ConstraintIssueCount
+	if config := s.BoardColumns.Configuration; config != nil && config.ColumnConfiguration.ConstraintType != "" {
+		constraint = config.ColumnConfiguration.ConstraintType
+	}
+	if constraint == ConstraintNone {
+		return nil
+	}
+
+	var violations []*WIPViolation
+	for _, ci := range s.Columns {
+		column := ci.Column
+		if column.Minimum == 0 && column.Maximum == 0 {
+			continue
+		}
+
+		var counted []*Issue
+		for _, issue := range ci.Issues {
+			if constraint == ConstraintIssueCountExclSubs && issue.Fields != nil && issue.Fields.Type.SubTask {
+				continue
+			}
+			counted = append(counted, issue)
+		}
+
+		v := &WIPViolation{Column: column, Count: len(counted), Issues: counted}
+		switch {
+		case column.Maximum > 0 && v.Count > column.Maximum:
+			v.Kind, v.Limit = WIPAboveMaximum, column.Maximum
+		case column.Minimum > 0 && v.Count < column.Minimum:
+			v.Kind, v.Limit = WIPBelowMinimum, column.Minimum
+		default:
+			continue
+		}
+		violations = append(violations, v)
+	}
+
+	return violations
+}
+
+// CheckWIP returns the columns of the board whose number of issues breaks their WIP
+// limits, from the current issues of the board (see BoardSnapshot.WIPViolations).
+//
+// GET /rest/agile/1.0/board/{boardId}/configuration
+// GET /rest/api/2/status
+// GET /rest/agile/1.0/board/{boardId}/issue
+func (b *BoardsService) CheckWIP(ctx context.Context, boardID int) (*WIPReport, *Response, error) {
+
+	snapshot, resp, err := b.Snapshot(ctx, boardID, &IssuesOptions{Fields: "summary,status,issuetype"})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	report := &WIPReport{
+		BoardID:    boardID,
+		CheckedAt:  time.Now(),
+		Violations: snapshot.WIPViolations(),
+	}
+
+	return report, resp, nil
+}
+
+// WatchWIP checks the WIP limits of the board every interval, starting immediately,
+// and calls fn with the report or the error of each check. It blocks until the context
+// is done and returns the error of the context.
+func (b *BoardsService) WatchWIP(ctx context.Context, boardID int, interval time.Duration, fn func(*WIPReport, error)) error {
+	if interval <= 0 {
+		return errors.New("jira: the WIP check interval must be positive")
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		report, _, err := b.CheckWIP(ctx, boardID)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		fn(report, err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoardsServiceCheckWIP(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleBoardColumns(mux)
+
+	mux.HandleFunc("/board/84/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "summary,status,issuetype", r.URL.Query().Get("fields"))
+		fmt.Fprint(w, `{"maxResults": 50,"issues": [
+			{"key": "MCP-1","fields": {"status": {"id": "3"}}},
+			{"key": "MCP-2","fields": {"status": {"id": "3"}}},
+			{"key": "MCP-3","fields": {"status": {"id": "3"}}},
+			{"key": "MCP-4","fields": {"status": {"id": "3"},"issuetype": {"subtask": true}}}]}`)
+	})
+
+	report, _, err := client.Boards.CheckWIP(context.Background(), 84)
+	assert.Nil(t, err)
+	assert.False(t, report.OK())
+	assert.Equal(t, 84, report.BoardID)
+	assert.Len(t, report.Violations, 1)
+
+	v := report.Violations[0]
+	assert.Equal(t, "In progress", v.Column.Name)
+	assert.Equal(t, WIPAboveMaximum, v.Kind)
+	assert.Equal(t, 2, v.Limit)
+	assert.Equal(t, 4, v.Count)
+	assert.Len(t, v.Issues, 4)
+	assert.Equal(t, "column In progress has 4 issues, maximum is 2", v.String())
+}
+
+func TestBoardSnapshotWIPViolations(t *testing.T) {
+	config := &Configuration{ColumnConfiguration: ColumnConfiguration{
+		ConstraintType: ConstraintIssueCountExclSubs,
+		Columns: []*ConfigurationColumn{
+			{Name: "Ready", Statuses: []*ConfigurationStatus{{ID: "1"}}, Minimum: 2},
+			{Name: "Doing", Statuses: []*ConfigurationStatus{{ID: "3"}}, Maximum: 1},
+		},
+	}}
+
+	issues := []*Issue{
+		{Key: "MCP-1", Fields: &IssueField{Status: &IssueStatus{ID: "1"}}},
+		{Key: "MCP-2", Fields: &IssueField{Status: &IssueStatus{ID: "3"}}},
+		{Key: "MCP-3", Fields: &IssueField{Status: &IssueStatus{ID: "3"}, Type: IssueType{SubTask: true}}},
+	}
+
+	snapshot := NewBoardSnapshot(NewBoardColumns(config, nil), issues)
+	violations := snapshot.WIPViolations()
+	assert.Len(t, violations, 1)
+	assert.Equal(t, WIPBelowMinimum, violations[0].Kind)
+	assert.Equal(t, "column Ready has 1 issues, minimum is 2", violations[0].String())
+
+	config.ColumnConfiguration.ConstraintType = ConstraintIssueCount
+	assert.Len(t, snapshot.WIPViolations(), 2)
+
+	config.ColumnConfiguration.ConstraintType = ConstraintNone
+	assert.Empty(t, snapshot.WIPViolations())
+}
+
+func TestBoardsServiceWatchWIP(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	handleBoardColumns(mux)
+
+	mux.HandleFunc("/board/84/issue", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"maxResults": 50,"issues": []}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	checks := 0
+	err := client.Boards.WatchWIP(ctx, 84, time.Millisecond, func(report *WIPReport, err error) {
+		assert.Nil(t, err)
+		// the minimum of the In progress column is broken
+		assert.Len(t, report.Violations, 1)
+		checks++
+		if checks == 2 {
+			cancel()
+		}
+	})
+
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 2, checks)
+
+	err = client.Boards.WatchWIP(context.Background(), 84, 0, nil)
+	assert.NotNil(t, err)
+}
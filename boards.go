@@ -74,6 +74,23 @@ type ConfigurationFilter struct {
 	SelfLink string `json:"self,omitempty"`
 }
 
+// ConfigurationLocation represents the location of a Jira Agile Board, the project or the
+// user it belongs to
+type ConfigurationLocation struct {
+	Type           string `json:"type,omitempty"`
+	Key            string `json:"key,omitempty"`
+	ID             string `json:"id,omitempty"`
+	ProjectKeyOrID string `json:"projectKeyOrId,omitempty"`
+	Name           string `json:"name,omitempty"`
+	SelfLink       string `json:"self,omitempty"`
+}
+
+// ConfigurationSubQuery represents the sub-query of a kanban board, which limits the
+// issues of the board, e.g. to hide the issues released long ago
+type ConfigurationSubQuery struct {
+	Query string `json:"query,omitempty"`
+}
+
 // ConfigurationEstimationField represents a Jira Agile Board Configuration Estimation Field
 type ConfigurationEstimationField struct {
 	ID   string `json:"fieldId,omitempty"`
@@ -116,7 +133,8 @@ type Configuration struct {
 	ID                  int                     `json:"id,omitempty"`
 	Name                string                  `json:"name,omitempty"`
 	SelfLink            string                  `json:"self,omitempty"`
-	SubQuery            string                  `json:"subQuery,omitempty"`
+	Location            ConfigurationLocation   `json:"location,omitempty"`
+	SubQuery            ConfigurationSubQuery   `json:"subQuery,omitempty"`
 	Filter              ConfigurationFilter     `json:"filter,omitempty"`
 	Estimation          ConfigurationEstimation `json:"estimation,omitempty"`
 	Ranking             ConfigurationRanking    `json:"ranking,omitempty"`
@@ -274,6 +292,30 @@ func (b *BoardsService) ListIssues(ctx context.Context, id int, opts *IssuesOpti
 	return wrap.Values, resp, nil
 }
 
+// MoveIssuesTo moves issues from the backlog to the board (if they are already in the backlog
+// of the board), for the given board Id. This operation is used by kanban boards that have the
+// backlog enabled. At most 50 issues may be moved at once. Issues can be ranked before or after
+// a given issue, using the same fields as IssuesService.Rank. This operation may fail for some
+// issues, in that case the 207 status code is returned for the whole response and detailed
+// information regarding each issue is available in the response body.
+//
+// POST /rest/agile/1.0/board/{boardId}/issue
+func (b *BoardsService) MoveIssuesTo(ctx context.Context, boardID int, rank *IssueRank) (*IssueRankEntry, *Response, error) {
+
+	req, err := b.client.NewRequest("POST", fmt.Sprintf("board/%d/issue", boardID), rank)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entries = &IssueRankEntry{}
+	resp, err := b.client.Do(ctx, req, entries)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return entries, resp, nil
+}
+
 // GetConfiguration returns the board configuration for the given board Id.
 // This board configuration will only be returned if the user has permission to view it.
 //
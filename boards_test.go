@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
 	"testing"
@@ -128,6 +129,34 @@ func TestBoardsServiceListIssues(t *testing.T) {
 	assert.Len(t, backlog, 1)
 }
 
+func TestBoardsServiceMoveIssuesTo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5259/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"issues": ["PR-1","PR-3"],"rankBeforeIssue": "PR-4","rankCustomFieldId": "10521"}`, string(body))
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprint(w, `{"entries": [{"issueId": 10000,"issueKey": "PR-1","status": 200},
+		{"issueId": 10002,"issueKey": "PR-3","status": 503,"errors": ["JIRA Agile cannot execute the rank operation at this time. Please try again later."]}]}`)
+	})
+
+	rank := &IssueRank{
+		Issues:            []string{"PR-1", "PR-3"},
+		RankBefore:        "PR-4",
+		RankCustomFieldID: "10521",
+	}
+
+	entries, resp, err := client.Boards.MoveIssuesTo(context.Background(), 5259, rank)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusMultiStatus, resp.StatusCode)
+	assert.Len(t, entries.Entries, 2)
+	assert.Equal(t, "PR-3", entries.Entries[1].Key)
+	assert.Equal(t, 503, entries.Entries[1].Status)
+	assert.Len(t, entries.Entries[1].Errors, 1)
+}
+
 func TestBoardsServiceGetConfiguration(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
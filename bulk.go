@@ -0,0 +1,185 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+)
+
+// MaxBulkIssues is the maximum number of issues that the API accepts in a single
+// move or rank operation. The Bulk* methods split larger lists into chunks of this size.
+const MaxBulkIssues = 50
+
+// BulkResult aggregates the per-issue outcomes of a bulk operation split in chunks.
+// Issues are successful when their status is 2xx.
+type BulkResult struct {
+	Entries []IssueRankStatus
+}
+
+// Failed returns the entries of the issues that could not be processed
+func (r *BulkResult) Failed() []IssueRankStatus {
+	var failed []IssueRankStatus
+	for _, e := range r.Entries {
+		if !successStatus(e.Status) {
+			failed = append(failed, e)
+		}
+	}
+	return failed
+}
+
+// OK reports whether all issues were processed successfully
+func (r *BulkResult) OK() bool {
+	return len(r.Failed()) == 0
+}
+
+func (r *BulkResult) add(keys []string, resp *Response, err error) {
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+
+	var messages []string
+	if errResp, ok := err.(*ErrorResponse); ok {
+		messages = append(messages, errResp.Messages...)
+		for field, msg := range errResp.Errors {
+			messages = append(messages, field+": "+msg)
+		}
+	}
+
+	for _, key := range keys {
+		r.Entries = append(r.Entries, IssueRankStatus{Key: key, Status: status, Errors: messages})
+	}
+}
+
+func successStatus(status int) bool {
+	return status >= 200 && status <= 299
+}
+
+// chunkKeys splits the keys in chunks of at most size keys
+func chunkKeys(keys []string, size int) [][]string {
+	var chunks [][]string
+	for len(keys) > size {
+		chunks = append(chunks, keys[:size])
+		keys = keys[size:]
+	}
+	if len(keys) > 0 {
+		chunks = append(chunks, keys)
+	}
+	return chunks
+}
+
+// bulkMove calls move once per chunk of issues. Failures reported by the API are recorded
+// in the result for the issues of the chunk and the remaining chunks are still processed.
+// Any other error, e.g. a canceled context, stops the operation and is returned.
+func bulkMove(ctx context.Context, issueKeys *IssueKeys, move func(context.Context, *IssueKeys) (bool, *Response, error)) (*BulkResult, *Response, error) {
+	result := &BulkResult{}
+	var resp *Response
+
+	for _, chunk := range chunkKeys(issueKeys.Issues, MaxBulkIssues) {
+		var err error
+		_, resp, err = move(ctx, &IssueKeys{Issues: chunk})
+		if _, ok := err.(*ErrorResponse); err != nil && !ok {
+			return result, resp, err
+		}
+		result.add(chunk, resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// BulkMoveIssuesTo moves any number of issues to a sprint, splitting them in chunks of
+// MaxBulkIssues issues. Failures reported by the API are recorded per issue in the
+// result, other errors stop the operation.
+//
+// POST /rest/agile/1.0/sprint/{sprintId}/issue
+func (s *SprintsService) BulkMoveIssuesTo(ctx context.Context, sprintID int, issueKeys *IssueKeys) (*BulkResult, *Response, error) {
+	return bulkMove(ctx, issueKeys, func(ctx context.Context, keys *IssueKeys) (bool, *Response, error) {
+		return s.MoveIssuesTo(ctx, sprintID, keys)
+	})
+}
+
+// BulkMoveIssuesTo moves any number of issues to an epic, splitting them in chunks of
+// MaxBulkIssues issues. Failures reported by the API are recorded per issue in the
+// result, other errors stop the operation.
+//
+// POST /rest/agile/1.0/epic/{epicIdOrKey}/issue
+func (e *EpicsService) BulkMoveIssuesTo(ctx context.Context, idOrKey string, issueKeys *IssueKeys) (*BulkResult, *Response, error) {
+	return bulkMove(ctx, issueKeys, func(ctx context.Context, keys *IssueKeys) (bool, *Response, error) {
+		return e.MoveIssuesTo(ctx, idOrKey, keys)
+	})
+}
+
+// BulkRemoveIssuesFrom removes any number of issues from epics, splitting them in chunks
+// of MaxBulkIssues issues. Failures reported by the API are recorded per issue in the
+// result, other errors stop the operation.
+//
+// POST /rest/agile/1.0/epic/none/issue
+func (e *EpicsService) BulkRemoveIssuesFrom(ctx context.Context, issueKeys *IssueKeys) (*BulkResult, *Response, error) {
+	return bulkMove(ctx, issueKeys, e.RemoveIssuesFrom)
+}
+
+// BulkMoveIssuesTo moves any number of issues to the backlog, splitting them in chunks
+// of MaxBulkIssues issues. Failures reported by the API are recorded per issue in the
+// result, other errors stop the operation.
+//
+// POST /rest/agile/1.0/backlog/issue
+func (b *BacklogService) BulkMoveIssuesTo(ctx context.Context, issueKeys *IssueKeys) (*BulkResult, *Response, error) {
+	return bulkMove(ctx, issueKeys, b.MoveIssuesTo)
+}
+
+// BulkRank ranks any number of issues before or after a given issue, splitting them in
+// chunks of MaxBulkIssues issues. The first chunk is ranked as requested and every next
+// chunk is ranked after the last issue successfully ranked so far, so the issues keep
+// their relative order. The per-issue entries of 207 responses are aggregated in the
+// result. Failures reported by the API are recorded per issue, other errors stop the
+// operation.
+//
+// PUT /rest/agile/1.0/issue/rank
+func (i *IssuesService) BulkRank(ctx context.Context, rank *IssueRank) (*BulkResult, *Response, error) {
+	result := &BulkResult{}
+	var resp *Response
+
+	anchor := &IssueRank{
+		RankAfter:         rank.RankAfter,
+		RankBefore:        rank.RankBefore,
+		RankCustomFieldID: rank.RankCustomFieldID,
+	}
+
+	for _, chunk := range chunkKeys(rank.Issues, MaxBulkIssues) {
+		r := *anchor
+		r.Issues = chunk
+
+		var entries *IssueRankEntry
+		var err error
+		entries, resp, err = i.Rank(ctx, &r)
+		if err != nil {
+			if _, ok := err.(*ErrorResponse); !ok {
+				return result, resp, err
+			}
+			result.add(chunk, resp, err)
+			continue
+		}
+
+		ranked := map[string]bool{}
+		if resp.StatusCode == http.StatusMultiStatus && entries != nil && len(entries.Entries) > 0 {
+			result.Entries = append(result.Entries, entries.Entries...)
+			for _, e := range entries.Entries {
+				ranked[e.Key] = successStatus(e.Status)
+			}
+		} else {
+			result.add(chunk, resp, nil)
+			for _, key := range chunk {
+				ranked[key] = true
+			}
+		}
+
+		for j := len(chunk) - 1; j >= 0; j-- {
+			if ranked[chunk[j]] {
+				anchor.RankAfter = chunk[j]
+				anchor.RankBefore = ""
+				break
+			}
+		}
+	}
+
+	return result, resp, nil
+}
@@ -0,0 +1,164 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func issueKeysRange(prefix string, n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("%s-%d", prefix, i+1)
+	}
+	return keys
+}
+
+func TestChunkKeys(t *testing.T) {
+	assert.Len(t, chunkKeys(nil, 50), 0)
+	assert.Len(t, chunkKeys(issueKeysRange("MCP", 50), 50), 1)
+
+	chunks := chunkKeys(issueKeysRange("MCP", 120), 50)
+	assert.Len(t, chunks, 3)
+	assert.Len(t, chunks[2], 20)
+	assert.Equal(t, "MCP-51", chunks[1][0])
+}
+
+func TestSprintsServiceBulkMoveIssuesTo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/sprint/11392/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		calls++
+
+		keys := &IssueKeys{}
+		json.NewDecoder(r.Body).Decode(keys)
+		assert.True(t, len(keys.Issues) <= MaxBulkIssues)
+
+		if calls == 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errorMessages": ["Issue MCP-60 does not exist"]}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	result, _, err := client.Sprints.BulkMoveIssuesTo(context.Background(), 11392, &IssueKeys{Issues: issueKeysRange("MCP", 120)})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Len(t, result.Entries, 120)
+	assert.False(t, result.OK())
+
+	failed := result.Failed()
+	assert.Len(t, failed, 50)
+	assert.Equal(t, "MCP-51", failed[0].Key)
+	assert.Equal(t, http.StatusBadRequest, failed[0].Status)
+	assert.Equal(t, []string{"Issue MCP-60 does not exist"}, failed[0].Errors)
+}
+
+func TestEpicsServiceBulkMoveIssuesTo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/epic/MCP-1/issue", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/epic/none/issue", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	result, _, err := client.Epics.BulkMoveIssuesTo(context.Background(), "MCP-1", &IssueKeys{Issues: issueKeysRange("MCP", 51)})
+	assert.Nil(t, err)
+	assert.True(t, result.OK())
+	assert.Equal(t, 2, calls)
+
+	result, _, err = client.Epics.BulkRemoveIssuesFrom(context.Background(), &IssueKeys{Issues: issueKeysRange("MCP", 101)})
+	assert.Nil(t, err)
+	assert.True(t, result.OK())
+	assert.Len(t, result.Entries, 101)
+	assert.Equal(t, 5, calls)
+}
+
+func TestBacklogServiceBulkMoveIssuesTo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/backlog/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, _, err := client.Backlog.BulkMoveIssuesTo(ctx, &IssueKeys{Issues: issueKeysRange("MCP", 60)})
+	assert.Equal(t, context.Canceled, err)
+	assert.Len(t, result.Entries, 0)
+}
+
+func TestIssuesServiceBulkRank(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var ranks []*IssueRank
+	mux.HandleFunc("/issue/rank", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+
+		rank := &IssueRank{}
+		json.NewDecoder(r.Body).Decode(rank)
+		ranks = append(ranks, rank)
+
+		if len(ranks) == 2 {
+			// the last issue of the chunk could not be ranked
+			w.WriteHeader(http.StatusMultiStatus)
+			var entries []string
+			for i, key := range rank.Issues {
+				status := 200
+				if i == len(rank.Issues)-1 {
+					status = 503
+				}
+				entries = append(entries, fmt.Sprintf(`{"issueKey": "%s","status": %d}`, key, status))
+			}
+			fmt.Fprintf(w, `{"entries": [%s]}`, strings.Join(entries, ","))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	rank := &IssueRank{
+		Issues:            issueKeysRange("MCP", 130),
+		RankBefore:        "MCP-500",
+		RankCustomFieldID: "10",
+	}
+	result, _, err := client.Issues.BulkRank(context.Background(), rank)
+	assert.Nil(t, err)
+	assert.Len(t, ranks, 3)
+
+	assert.Equal(t, "MCP-500", ranks[0].RankBefore)
+	assert.Equal(t, "", ranks[0].RankAfter)
+	assert.Len(t, ranks[0].Issues, 50)
+
+	assert.Equal(t, "MCP-50", ranks[1].RankAfter)
+	assert.Equal(t, "", ranks[1].RankBefore)
+	assert.Equal(t, "MCP-51", ranks[1].Issues[0])
+
+	// MCP-100 failed, so MCP-99 is the anchor of the last chunk
+	assert.Equal(t, "MCP-99", ranks[2].RankAfter)
+	assert.Len(t, ranks[2].Issues, 30)
+	assert.Equal(t, "10", ranks[2].RankCustomFieldID)
+
+	assert.Len(t, result.Entries, 130)
+	failed := result.Failed()
+	assert.Len(t, failed, 1)
+	assert.Equal(t, "MCP-100", failed[0].Key)
+	assert.Equal(t, 503, failed[0].Status)
+}
@@ -0,0 +1,260 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ComponentsService handles communication with the component related
+// methods of the Jira platform API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/component
+type ComponentsService service
+
+// Default assignee types of a component
+const (
+	ComponentAssigneeProjectDefault = "PROJECT_DEFAULT"
+	ComponentAssigneeComponentLead  = "COMPONENT_LEAD"
+	ComponentAssigneeProjectLead    = "PROJECT_LEAD"
+	ComponentAssigneeUnassigned     = "UNASSIGNED"
+)
+
+// ComponentIssueCount contains the number of issues of a component
+type ComponentIssueCount struct {
+	SelfLink   string `json:"self,omitempty"`
+	IssueCount int    `json:"issueCount"`
+}
+
+// DeleteComponentOptions contains the options to delete a component
+type DeleteComponentOptions struct {
+	//The new component applied to issues whose component has been deleted.
+	MoveIssuesTo string `query:"moveIssuesTo"`
+}
+
+// ComponentSyncResult contains the components of a project created, updated and left
+// unchanged by a sync
+type ComponentSyncResult struct {
+	Created   []*IssueComponent
+	Updated   []*IssueComponent
+	Unchanged []*IssueComponent
+}
+
+// ListForProject returns all components of a project, for the given project id or key.
+//
+// GET /rest/api/2/project/{projectIdOrKey}/components
+func (c *ComponentsService) ListForProject(ctx context.Context, projectIDOrKey string) ([]*IssueComponent, *Response, error) {
+
+	req, err := c.client.NewRequest("GET", fmt.Sprintf("%sproject/%s/components", apiPath, projectIDOrKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var components []*IssueComponent
+	resp, err := c.client.Do(ctx, req, &components)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return components, resp, nil
+}
+
+// Get returns the component for the given component id.
+//
+// GET /rest/api/2/component/{id}
+func (c *ComponentsService) Get(ctx context.Context, id string) (*IssueComponent, *Response, error) {
+
+	req, err := c.client.NewRequest("GET", fmt.Sprintf("%scomponent/%s", apiPath, id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var component = &IssueComponent{}
+	resp, err := c.client.Do(ctx, req, component)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return component, resp, nil
+}
+
+// Create creates a component in the project defined by Project (its key). The lead is
+// set with LeadUserName or LeadAccountID.
+//
+// POST /rest/api/2/component
+func (c *ComponentsService) Create(ctx context.Context, component *IssueComponent) (*IssueComponent, *Response, error) {
+
+	req, err := c.client.NewRequest("POST", apiPath+"component", writableComponent(component))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created = &IssueComponent{}
+	resp, err := c.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// Update updates a component, only the fields present in the request are updated. The
+// lead is set with LeadUserName or LeadAccountID.
+//
+// PUT /rest/api/2/component/{id}
+func (c *ComponentsService) Update(ctx context.Context, id string, component *IssueComponent) (*IssueComponent, *Response, error) {
+
+	req, err := c.client.NewRequest("PUT", fmt.Sprintf("%scomponent/%s", apiPath, id), writableComponent(component))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated = &IssueComponent{}
+	resp, err := c.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, nil
+}
+
+// writableComponent returns a copy of the component without the read-only fields
+func writableComponent(component *IssueComponent) *IssueComponent {
+	w := *component
+	w.ID = ""
+	w.SelfLink = ""
+	w.Lead = nil
+	w.Assignee = nil
+	w.RealAssignee = nil
+	w.RealAssigneeType = ""
+	w.AssigneeTypeValid = false
+	return &w
+}
+
+// Delete deletes the component. Its issues are moved to the component defined by the
+// options, the component is removed from the issues otherwise.
+//
+// DELETE /rest/api/2/component/{id}
+func (c *ComponentsService) Delete(ctx context.Context, id string, opts *DeleteComponentOptions) (bool, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := c.client.NewRequest("DELETE", fmt.Sprintf("%scomponent/%s%s", apiPath, id, q), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := c.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// GetIssueCount returns the number of issues of the component.
+//
+// GET /rest/api/2/component/{id}/relatedIssueCounts
+func (c *ComponentsService) GetIssueCount(ctx context.Context, id string) (*ComponentIssueCount, *Response, error) {
+
+	req, err := c.client.NewRequest("GET", fmt.Sprintf("%scomponent/%s/relatedIssueCounts", apiPath, id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var count = &ComponentIssueCount{}
+	resp, err := c.client.Do(ctx, req, count)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return count, resp, nil
+}
+
+// Sync makes the components of the project match the given ones, e.g. the components of
+// a service catalog. Components are matched by name, ignoring case. Missing components
+// are created and the description, lead and assignee type of the existing ones are
+// updated when they differ. Description, lead and assignee type are only compared when
+// they are defined. Components of the project that are not given are left as is.
+//
+// GET /rest/api/2/project/{projectIdOrKey}/components
+// POST /rest/api/2/component
+// PUT /rest/api/2/component/{id}
+func (c *ComponentsService) Sync(ctx context.Context, projectKey string, components []*IssueComponent) (*ComponentSyncResult, *Response, error) {
+
+	existing, resp, err := c.ListForProject(ctx, projectKey)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	byName := make(map[string]*IssueComponent, len(existing))
+	for _, e := range existing {
+		byName[strings.ToLower(e.Name)] = e
+	}
+
+	result := &ComponentSyncResult{}
+	for _, component := range components {
+		current, ok := byName[strings.ToLower(component.Name)]
+		if !ok {
+			create := *component
+			create.Project = projectKey
+
+			var created *IssueComponent
+			created, resp, err = c.Create(ctx, &create)
+			if err != nil {
+				return result, resp, err
+			}
+			result.Created = append(result.Created, created)
+			continue
+		}
+
+		if componentInSync(current, component) {
+			result.Unchanged = append(result.Unchanged, current)
+			continue
+		}
+
+		update := &IssueComponent{
+			Description:   component.Description,
+			LeadUserName:  component.LeadUserName,
+			LeadAccountID: component.LeadAccountID,
+			AssigneeType:  component.AssigneeType,
+		}
+
+		var updated *IssueComponent
+		updated, resp, err = c.Update(ctx, current.ID, update)
+		if err != nil {
+			return result, resp, err
+		}
+		result.Updated = append(result.Updated, updated)
+	}
+
+	return result, resp, nil
+}
+
+// componentInSync reports whether the current component matches the wanted one
+func componentInSync(current, wanted *IssueComponent) bool {
+	if wanted.Description != "" && current.Description != wanted.Description {
+		return false
+	}
+	if wanted.AssigneeType != "" && current.AssigneeType != wanted.AssigneeType {
+		return false
+	}
+
+	var lead IssueUser
+	if current.Lead != nil {
+		lead = *current.Lead
+	}
+	if wanted.LeadUserName != "" && lead.Name != wanted.LeadUserName && current.LeadUserName != wanted.LeadUserName {
+		return false
+	}
+	if wanted.LeadAccountID != "" && lead.AccountID != wanted.LeadAccountID && current.LeadAccountID != wanted.LeadAccountID {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,158 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const componentJSON = `{"self": "http://www.example.com/jira/rest/api/2/component/10000","id": "10000","name": "Component 1",
+	"description": "This is a JIRA component","lead": {"name": "fred","accountId": "5b10a2844c20165700ede21g","displayName": "Fred F. User"},
+	"assigneeType": "PROJECT_LEAD","realAssigneeType": "PROJECT_LEAD","isAssigneeTypeValid": false,"project": "HSP","projectId": 10000}`
+
+func TestComponentsServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, componentJSON)
+	})
+
+	component, _, err := client.Components.Get(context.Background(), "10000")
+	assert.Nil(t, err)
+
+	want := &IssueComponent{
+		ID:               "10000",
+		Name:             "Component 1",
+		SelfLink:         "http://www.example.com/jira/rest/api/2/component/10000",
+		Description:      "This is a JIRA component",
+		Lead:             &IssueUser{Name: "fred", AccountID: "5b10a2844c20165700ede21g", DisplayName: "Fred F. User"},
+		AssigneeType:     ComponentAssigneeProjectLead,
+		RealAssigneeType: ComponentAssigneeProjectLead,
+		Project:          "HSP",
+		ProjectID:        10000,
+	}
+	assert.Equal(t, want, component)
+}
+
+func TestComponentsServiceListForProject(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project/HSP/components", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprintf(w, `[%s]`, componentJSON)
+	})
+
+	components, _, err := client.Components.ListForProject(context.Background(), "HSP")
+	assert.Nil(t, err)
+	assert.Len(t, components, 1)
+	assert.Equal(t, "fred", components[0].Lead.Name)
+}
+
+func TestComponentsServiceCreateUpdateDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/component", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"name": "Component 1", "leadUserName": "fred", "assigneeType": "COMPONENT_LEAD", "project": "HSP"}, body)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, componentJSON)
+	})
+
+	mux.HandleFunc("/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			body := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, map[string]interface{}{"description": "Updated"}, body)
+			fmt.Fprint(w, componentJSON)
+		case "DELETE":
+			assert.Equal(t, "10001", r.URL.Query().Get("moveIssuesTo"))
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	newComponent := &IssueComponent{
+		Name:         "Component 1",
+		LeadUserName: "fred",
+		AssigneeType: ComponentAssigneeComponentLead,
+		Project:      "HSP",
+	}
+	component, _, err := client.Components.Create(context.Background(), newComponent)
+	assert.Nil(t, err)
+	assert.Equal(t, "10000", component.ID)
+
+	// read-only fields are not sent
+	_, _, err = client.Components.Update(context.Background(), "10000", &IssueComponent{Description: "Updated", Lead: component.Lead, ID: "10000"})
+	assert.Nil(t, err)
+
+	deleted, _, err := client.Components.Delete(context.Background(), "10000", &DeleteComponentOptions{MoveIssuesTo: "10001"})
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
+
+func TestComponentsServiceGetIssueCount(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/component/10000/relatedIssueCounts", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"self": "http://www.example.com/jira/rest/api/2/component/10000","issueCount": 23}`)
+	})
+
+	count, _, err := client.Components.GetIssueCount(context.Background(), "10000")
+	assert.Nil(t, err)
+	assert.Equal(t, 23, count.IssueCount)
+}
+
+func TestComponentsServiceSync(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project/HSP/components", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "1","name": "Billing","description": "Invoices","lead": {"name": "fred"}},
+			{"id": "2","name": "Search","description": "Old"},
+			{"id": "3","name": "Legacy"},{"id": "5","name": "Docs","description": "Manuals"}]`)
+	})
+
+	var created []string
+	mux.HandleFunc("/api/2/component", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		c := &IssueComponent{}
+		json.NewDecoder(r.Body).Decode(c)
+		assert.Equal(t, "HSP", c.Project)
+		created = append(created, c.Name)
+		fmt.Fprintf(w, `{"id": "4","name": "%s"}`, c.Name)
+	})
+
+	mux.HandleFunc("/api/2/component/2", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		fmt.Fprint(w, `{"id": "2","name": "Search","description": "Full text search"}`)
+	})
+
+	components := []*IssueComponent{
+		{Name: "billing", Description: "Invoices", LeadUserName: "fred"},
+		{Name: "Search", Description: "Full text search"},
+		{Name: "Payments"},
+		// no description, the one of the project is kept
+		{Name: "docs"},
+	}
+
+	result, _, err := client.Components.Sync(context.Background(), "HSP", components)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Payments"}, created)
+	assert.Len(t, result.Created, 1)
+	assert.Len(t, result.Updated, 1)
+	assert.Equal(t, "Full text search", result.Updated[0].Description)
+	assert.Len(t, result.Unchanged, 2)
+	assert.Equal(t, "1", result.Unchanged[0].ID)
+	assert.Equal(t, "Manuals", result.Unchanged[1].Description)
+}
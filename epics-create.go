@@ -0,0 +1,204 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// NewEpic contains the fields to create an epic
+type NewEpic struct {
+	//Key of the project where the epic is created.
+	ProjectKey string
+	//Name of the epic, stored in the Epic Name field of company-managed projects. Default: Summary.
+	Name string
+	//Summary of the epic. Default: Name.
+	Summary string
+	//Description of the epic.
+	Description string
+	//Other fields of the epic indexed by field id, e.g. "labels" or "customfield_10010".
+	Fields map[string]interface{}
+	//Keys of the issues added to the epic once it is created.
+	Issues []string
+}
+
+// epicMeta contains what is needed to create epics in a project
+type epicMeta struct {
+	issueTypeID string
+	// id of the Epic Name field, empty for team-managed projects
+	nameFieldID string
+}
+
+// teamManaged reports whether the project is team-managed (next-gen), in which epics
+// have no Epic Name and issues are added to epics through their parent
+func (m *epicMeta) teamManaged() bool {
+	return m.nameFieldID == ""
+}
+
+// epicMeta discovers the epic issue type and the Epic Name field of the project. In
+// company-managed projects, the epic issue type is the one with the Epic Name field, in
+// team-managed projects it is the issue type of level 1 of the hierarchy.
+func (e *EpicsService) epicMeta(ctx context.Context, projectKey string) (*epicMeta, *Response, error) {
+	opts := &CreateMetaOptions{ProjectKeys: projectKey, Expand: "projects.issuetypes.fields"}
+
+	meta, resp, err := e.client.Issues.GetCreateMeta(ctx, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, project := range meta.Projects {
+		if project.Key != projectKey {
+			continue
+		}
+
+		var epicType *CreateMetaIssueType
+		for _, t := range project.IssueTypes {
+			if id, _ := t.FieldByType(EpicNameFieldType); id != "" {
+				return &epicMeta{issueTypeID: t.ID, nameFieldID: id}, resp, nil
+			}
+			if epicType == nil && !t.SubTask && (t.HierarchyLevel == 1 || strings.EqualFold(t.Name, "epic")) {
+				epicType = t
+			}
+		}
+
+		if epicType != nil {
+			return &epicMeta{issueTypeID: epicType.ID}, resp, nil
+		}
+	}
+
+	return nil, resp, fmt.Errorf("jira: no epic issue type found in project %s", projectKey)
+}
+
+// Create creates an epic and returns it once created. The epic issue type and the Epic Name
+// field are discovered from the create metadata of the project, so they do not need to be
+// configured. In team-managed (next-gen) projects, which have no Epic Name field, the name
+// is only used as summary. The issues of the new epic are added through the Epic Link field
+// in company-managed projects and through their parent in team-managed projects. The epic
+// is returned with an error when any issue could not be added to it. Once the epic exists,
+// it is always returned, with at least its key, even when a later request fails.
+//
+// GET /rest/api/2/issue/createmeta
+// POST /rest/api/2/issue
+// POST /rest/agile/1.0/epic/{epicIdOrKey}/issue or PUT /rest/api/2/issue/{issueIdOrKey}
+// GET /rest/agile/1.0/epic/{epicIdOrKey}
+func (e *EpicsService) Create(ctx context.Context, newEpic *NewEpic) (*Epic, *Response, error) {
+
+	if newEpic.ProjectKey == "" {
+		return nil, nil, fmt.Errorf("jira: the project key is required to create an epic")
+	}
+
+	name, summary := newEpic.Name, newEpic.Summary
+	if name == "" {
+		name = summary
+	}
+	if summary == "" {
+		summary = name
+	}
+	if summary == "" {
+		return nil, nil, fmt.Errorf("jira: the name or the summary is required to create an epic")
+	}
+
+	meta, resp, err := e.epicMeta(ctx, newEpic.ProjectKey)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	fields := map[string]interface{}{}
+	for id, value := range newEpic.Fields {
+		fields[id] = value
+	}
+	fields["project"] = map[string]string{"key": newEpic.ProjectKey}
+	fields["issuetype"] = map[string]string{"id": meta.issueTypeID}
+	fields["summary"] = summary
+	if newEpic.Description != "" {
+		fields["description"] = newEpic.Description
+	}
+	if !meta.teamManaged() {
+		fields[meta.nameFieldID] = name
+	}
+
+	req, err := e.client.NewRequest("POST", apiPath+"issue", map[string]interface{}{"fields": fields})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created = &Issue{}
+	resp, err = e.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var added *BulkResult
+	if len(newEpic.Issues) > 0 {
+		if meta.teamManaged() {
+			added, resp, err = e.setParent(ctx, created.Key, newEpic.Issues)
+		} else {
+			added, resp, err = e.BulkMoveIssuesTo(ctx, created.Key, &IssueKeys{Issues: newEpic.Issues})
+		}
+		if err != nil {
+			return &Epic{Key: created.Key, SelfLink: created.SelfLink}, resp, err
+		}
+	}
+
+	epic, resp, err := e.Get(ctx, created.Key)
+	if err != nil {
+		return &Epic{Key: created.Key, SelfLink: created.SelfLink}, resp, err
+	}
+
+	if added != nil && !added.OK() {
+		return epic, resp, fmt.Errorf("jira: epic %s created but %d issues could not be added to it", epic.Key, len(added.Failed()))
+	}
+
+	return epic, resp, nil
+}
+
+// setParent sets the parent of the issues to the epic, which is how issues are added to
+// epics in team-managed projects. Failures reported by the API are recorded per issue in
+// the result, other errors stop the operation.
+func (e *EpicsService) setParent(ctx context.Context, epicKey string, issues []string) (*BulkResult, *Response, error) {
+	result := &BulkResult{}
+	var resp *Response
+
+	edit := map[string]interface{}{
+		"fields": map[string]interface{}{"parent": map[string]string{"key": epicKey}},
+	}
+
+	for _, key := range issues {
+		req, err := e.client.NewRequest("PUT", fmt.Sprintf("%sissue/%s", apiPath, key), edit)
+		if err != nil {
+			return result, nil, err
+		}
+
+		resp, err = e.client.Do(ctx, req, nil)
+		if _, ok := err.(*ErrorResponse); err != nil && !ok {
+			return result, resp, err
+		}
+		result.add([]string{key}, resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// Delete deletes an epic. The issues of the epic are not deleted, they no longer belong
+// to any epic.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}
+func (e *EpicsService) Delete(ctx context.Context, idOrKey string) (bool, *Response, error) {
+
+	req, err := e.client.NewRequest("DELETE", fmt.Sprintf("%sissue/%s", apiPath, idOrKey), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := e.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
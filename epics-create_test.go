@@ -0,0 +1,163 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const classicCreateMeta = `{"projects": [{"id": "10000","key": "MCP","issuetypes": [
+	{"id": "10002","name": "Story","fields": {}},
+	{"id": "10001","name": "Epic","fields": {"customfield_10011": {"required": true,"name": "Epic Name","schema": {"type": "string","custom": "com.pyxis.greenhopper.jira:gh-epic-label"}}}}]}]}`
+
+const teamManagedCreateMeta = `{"projects": [{"id": "10100","key": "NG","issuetypes": [
+	{"id": "10200","name": "Story","hierarchyLevel": 0,"fields": {}},
+	{"id": "10201","name": "Épico","hierarchyLevel": 1,"fields": {}}]}]}`
+
+func TestEpicsServiceCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "MCP", r.URL.Query().Get("projectKeys"))
+		fmt.Fprint(w, classicCreateMeta)
+	})
+
+	mux.HandleFunc("/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		body := map[string]map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		fields := body["fields"]
+		assert.Equal(t, map[string]interface{}{"key": "MCP"}, fields["project"])
+		assert.Equal(t, map[string]interface{}{"id": "10001"}, fields["issuetype"])
+		assert.Equal(t, "Checkout", fields["summary"])
+		assert.Equal(t, "Checkout", fields["customfield_10011"])
+		assert.Equal(t, []interface{}{"web"}, fields["labels"])
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "10050","key": "MCP-9","self": "https://jira.mycompany.com/rest/api/2/issue/10050"}`)
+	})
+
+	mux.HandleFunc("/epic/MCP-9/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("/epic/MCP-9", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": 10050,"key": "MCP-9","name": "Checkout","summary": "Checkout","done": false}`)
+	})
+
+	newEpic := &NewEpic{
+		ProjectKey: "MCP",
+		Name:       "Checkout",
+		Fields:     map[string]interface{}{"labels": []string{"web"}},
+		Issues:     []string{"MCP-1", "MCP-2"},
+	}
+
+	epic, _, err := client.Epics.Create(context.Background(), newEpic)
+	assert.Nil(t, err)
+	assert.Equal(t, &Epic{ID: 10050, Key: "MCP-9", Name: "Checkout", Summary: "Checkout"}, epic)
+}
+
+func TestEpicsServiceCreateTeamManaged(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, teamManagedCreateMeta)
+	})
+
+	mux.HandleFunc("/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		fields := body["fields"]
+		assert.Equal(t, map[string]interface{}{"id": "10201"}, fields["issuetype"])
+		assert.Len(t, fields, 3)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "10300","key": "NG-5"}`)
+	})
+
+	var parents []string
+	mux.HandleFunc("/api/2/issue/NG-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body := map[string]map[string]map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		parents = append(parents, body["fields"]["parent"]["key"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("/api/2/issue/NG-2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages": [],"errors": {"parent": "Issue type can not have a parent"}}`)
+	})
+
+	mux.HandleFunc("/epic/NG-5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 10300,"key": "NG-5","name": "Checkout","summary": "Checkout"}`)
+	})
+
+	newEpic := &NewEpic{ProjectKey: "NG", Summary: "Checkout", Issues: []string{"NG-1", "NG-2"}}
+
+	epic, _, err := client.Epics.Create(context.Background(), newEpic)
+	assert.NotNil(t, err)
+	assert.Equal(t, "NG-5", epic.Key)
+	assert.Equal(t, []string{"NG-5"}, parents)
+}
+
+func TestEpicsServiceCreateGetFailure(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, classicCreateMeta)
+	})
+
+	mux.HandleFunc("/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "10050","key": "MCP-9","self": "https://jira.mycompany.com/rest/api/2/issue/10050"}`)
+	})
+
+	mux.HandleFunc("/epic/MCP-9", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	epic, _, err := client.Epics.Create(context.Background(), &NewEpic{ProjectKey: "MCP", Name: "Checkout"})
+	assert.NotNil(t, err)
+	assert.Equal(t, &Epic{Key: "MCP-9", SelfLink: "https://jira.mycompany.com/rest/api/2/issue/10050"}, epic)
+}
+
+func TestEpicsServiceCreateWithoutEpicType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"projects": [{"id": "10000","key": "MCP","issuetypes": [{"id": "10002","name": "Story"}]}]}`)
+	})
+
+	_, _, err := client.Epics.Create(context.Background(), &NewEpic{ProjectKey: "MCP", Name: "Checkout"})
+	assert.NotNil(t, err)
+
+	_, _, err = client.Epics.Create(context.Background(), &NewEpic{ProjectKey: "MCP"})
+	assert.NotNil(t, err)
+}
+
+func TestEpicsServiceDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-9", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	deleted, _, err := client.Epics.Delete(context.Background(), "MCP-9")
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
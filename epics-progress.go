@@ -0,0 +1,239 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// Status category keys
+const (
+	StatusCategoryToDo       = "new"
+	StatusCategoryInProgress = "indeterminate"
+	StatusCategoryDone       = "done"
+)
+
+// EpicProgress contains the rollup of the issues of an epic. Estimates are a number of
+// seconds when the board estimates using time and a number of points otherwise, they are
+// not computed when the board does not estimate using a field.
+type EpicProgress struct {
+	Epic *Epic
+	//Kind of the estimates.
+	Kind EstimateKind
+	//Number of issues of the epic.
+	Issues int
+	//Number of issues by status category: to do, in progress and done. Issues without
+	//status category are counted as to do.
+	ToDo       int
+	InProgress int
+	Done       int
+	//Sum of the estimates of the issues.
+	Estimated float64
+	//Sum of the estimates of the done issues.
+	Completed float64
+	//Number of issues without estimate.
+	Unestimated int
+	//Number of done issues without estimate.
+	UnestimatedDone int
+}
+
+// Ratio returns the completed part of the epic, between 0 and 1. It is the ratio of
+// completed to estimated work when the issues are estimated and the ratio of done
+// issues otherwise.
+func (p *EpicProgress) Ratio() float64 {
+	if p.Estimated > 0 {
+		return p.Completed / p.Estimated
+	}
+	if p.Issues > 0 {
+		return float64(p.Done) / float64(p.Issues)
+	}
+	return 0
+}
+
+// add adds the issue to the rollup
+func (p *EpicProgress) add(issue *Issue, fieldID string) {
+	p.Issues++
+
+	done := false
+	category := ""
+	if issue.Fields != nil && issue.Fields.Status != nil && issue.Fields.Status.Category != nil {
+		category = issue.Fields.Status.Category.Key
+	}
+
+	switch category {
+	case StatusCategoryDone:
+		p.Done++
+		done = true
+	case StatusCategoryInProgress:
+		p.InProgress++
+	default:
+		p.ToDo++
+	}
+
+	if p.Kind != EstimateTime && p.Kind != EstimatePoints {
+		return
+	}
+
+	value, ok := issueEstimate(issue, fieldID)
+	if !ok {
+		p.Unestimated++
+		if done {
+			p.UnestimatedDone++
+		}
+		return
+	}
+
+	p.Estimated += value
+	if done {
+		p.Completed += value
+	}
+}
+
+// issueEstimate returns the value of the estimation field of the issue, the time tracking
+// fields are only considered estimated when they are not zero
+func issueEstimate(issue *Issue, fieldID string) (float64, bool) {
+	f := issue.Fields
+	if f == nil {
+		return 0, false
+	}
+
+	var seconds int
+	switch fieldID {
+	case "timeoriginalestimate":
+		seconds = f.TimeOriginalEstimate
+	case "timeestimate":
+		seconds = f.TimeEstimate
+	case "aggregatetimeoriginalestimate":
+		seconds = f.AggregateTimeOriginalEstimate
+	case "aggregatetimeestimate":
+		seconds = f.AggregateTimeEstimate
+	default:
+		raw, ok := f.Custom[fieldID]
+		if !ok {
+			return 0, false
+		}
+
+		var value float64
+		if err := json.Unmarshal(raw, &value); err != nil {
+			// some fields return numbers as strings
+			var s string
+			if json.Unmarshal(raw, &s) != nil {
+				return 0, false
+			}
+			if value, err = strconv.ParseFloat(s, 64); err != nil {
+				return 0, false
+			}
+		}
+		return value, true
+	}
+
+	return float64(seconds), seconds != 0
+}
+
+// progressFields returns the fields of the issues needed to compute a rollup
+func progressFields(estimation ConfigurationEstimation) string {
+	kind := estimation.Kind()
+	if (kind == EstimateTime || kind == EstimatePoints) && estimation.Field.ID != "" {
+		return "status," + estimation.Field.ID
+	}
+	return "status"
+}
+
+// rollup computes the progress of the epic from its issues
+func rollup(epic *Epic, issues []*Issue, estimation ConfigurationEstimation) *EpicProgress {
+	p := &EpicProgress{Epic: epic, Kind: estimation.Kind()}
+	for _, issue := range issues {
+		p.add(issue, estimation.Field.ID)
+	}
+	return p
+}
+
+// ListEpicProgress returns the progress of the epics of the board. The issues of each epic
+// are counted by status category and their estimates are summed according to the board's
+// estimation configuration (see BoardsService.GetConfiguration).
+//
+// GET /rest/agile/1.0/board/{boardId}/configuration
+// GET /rest/agile/1.0/board/{boardId}/epic
+// GET /rest/agile/1.0/board/{boardId}/epic/{epicId}/issue
+func (b *BoardsService) ListEpicProgress(ctx context.Context, boardID int, opts *EpicsOptions) ([]*EpicProgress, *Response, error) {
+
+	config, resp, err := b.GetConfiguration(ctx, boardID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	o := EpicsOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var epics []*Epic
+	for {
+		var page []*Epic
+		page, resp, err = b.ListEpics(ctx, boardID, &o)
+		if err != nil {
+			return nil, resp, err
+		}
+		epics = append(epics, page...)
+
+		if resp.IsLast || len(page) == 0 {
+			break
+		}
+		o.StartAt += len(page)
+	}
+
+	fields := progressFields(config.Estimation)
+
+	progress := make([]*EpicProgress, 0, len(epics))
+	for _, epic := range epics {
+		epicID := epic.ID
+		list := func(ctx context.Context, opts *IssuesOptions) ([]*Issue, *Response, error) {
+			return b.ListIssuesForEpic(ctx, boardID, epicID, opts)
+		}
+
+		var issues []*Issue
+		issues, resp, err = listAllIssues(ctx, &IssuesOptions{Fields: fields}, list)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		progress = append(progress, rollup(epic, issues, config.Estimation))
+	}
+
+	return progress, resp, nil
+}
+
+// Progress returns the progress of the epic. The estimates of the issues are summed
+// according to the estimation configuration of the board (see BoardsService.GetConfiguration),
+// only the issues are counted when boardID is 0.
+//
+// GET /rest/agile/1.0/board/{boardId}/configuration
+// GET /rest/agile/1.0/epic/{epicIdOrKey}
+// GET /rest/agile/1.0/epic/{epicIdOrKey}/issue
+func (e *EpicsService) Progress(ctx context.Context, idOrKey string, boardID int) (*EpicProgress, *Response, error) {
+
+	estimation := ConfigurationEstimation{Type: string(EstimateNone)}
+	if boardID != 0 {
+		config, resp, err := e.client.Boards.GetConfiguration(ctx, boardID)
+		if err != nil {
+			return nil, resp, err
+		}
+		estimation = config.Estimation
+	}
+
+	epic, resp, err := e.Get(ctx, idOrKey)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	list := func(ctx context.Context, opts *IssuesOptions) ([]*Issue, *Response, error) {
+		return e.ListIssues(ctx, idOrKey, opts)
+	}
+
+	issues, resp, err := listAllIssues(ctx, &IssuesOptions{Fields: progressFields(estimation)}, list)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rollup(epic, issues, estimation), resp, nil
+}
@@ -0,0 +1,101 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoardsServiceListEpicProgress(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/84/configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 84,"estimation": {"type": "field","field": {"fieldId": "customfield_10002","displayName": "Story Points"}}}`)
+	})
+
+	mux.HandleFunc("/board/84/epic", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		if r.URL.Query().Get("startAt") == "" {
+			fmt.Fprint(w, `{"maxResults": 1,"isLast": false,"values": [{"id": 1,"key": "MCP-1","name": "Checkout"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"maxResults": 1,"startAt": 1,"isLast": true,"values": [{"id": 2,"key": "MCP-2","name": "Search"}]}`)
+	})
+
+	mux.HandleFunc("/board/84/epic/1/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "status,customfield_10002", r.URL.Query().Get("fields"))
+		fmt.Fprint(w, `{"maxResults": 50,"issues": [
+			{"key": "MCP-3","fields": {"status": {"statusCategory": {"key": "done"}},"customfield_10002": 5}},
+			{"key": "MCP-4","fields": {"status": {"statusCategory": {"key": "indeterminate"}},"customfield_10002": "3"}},
+			{"key": "MCP-5","fields": {"status": {"statusCategory": {"key": "new"}},"customfield_10002": null}},
+			{"key": "MCP-6","fields": {"status": {"statusCategory": {"key": "done"}}}}]}`)
+	})
+
+	mux.HandleFunc("/board/84/epic/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"maxResults": 50,"issues": []}`)
+	})
+
+	progress, _, err := client.Boards.ListEpicProgress(context.Background(), 84, nil)
+	assert.Nil(t, err)
+	assert.Len(t, progress, 2)
+
+	p := progress[0]
+	assert.Equal(t, "MCP-1", p.Epic.Key)
+	assert.Equal(t, EstimatePoints, p.Kind)
+	assert.Equal(t, 4, p.Issues)
+	assert.Equal(t, 1, p.ToDo)
+	assert.Equal(t, 1, p.InProgress)
+	assert.Equal(t, 2, p.Done)
+	assert.Equal(t, 8.0, p.Estimated)
+	assert.Equal(t, 5.0, p.Completed)
+	assert.Equal(t, 2, p.Unestimated)
+	assert.Equal(t, 1, p.UnestimatedDone)
+	assert.Equal(t, 5.0/8.0, p.Ratio())
+
+	assert.Equal(t, "MCP-2", progress[1].Epic.Key)
+	assert.Equal(t, 0, progress[1].Issues)
+	assert.Equal(t, 0.0, progress[1].Ratio())
+}
+
+func TestEpicsServiceProgress(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/84/configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 84,"estimation": {"type": "field","field": {"fieldId": "timeoriginalestimate","displayName": "Original Time Estimate"}}}`)
+	})
+
+	mux.HandleFunc("/epic/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1,"key": "MCP-1","name": "Checkout"}`)
+	})
+
+	var fields []string
+	mux.HandleFunc("/epic/MCP-1/issue", func(w http.ResponseWriter, r *http.Request) {
+		fields = append(fields, r.URL.Query().Get("fields"))
+		fmt.Fprint(w, `{"maxResults": 50,"issues": [
+			{"key": "MCP-3","fields": {"status": {"statusCategory": {"key": "done"}},"timeoriginalestimate": 3600}},
+			{"key": "MCP-4","fields": {"status": {"statusCategory": {"key": "new"}},"timeoriginalestimate": 7200}},
+			{"key": "MCP-5","fields": {"status": {"statusCategory": {"key": "new"}}}}]}`)
+	})
+
+	p, _, err := client.Epics.Progress(context.Background(), "MCP-1", 84)
+	assert.Nil(t, err)
+	assert.Equal(t, EstimateTime, p.Kind)
+	assert.Equal(t, 10800.0, p.Estimated)
+	assert.Equal(t, 3600.0, p.Completed)
+	assert.Equal(t, 1, p.Unestimated)
+
+	p, _, err = client.Epics.Progress(context.Background(), "MCP-1", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, EstimateNone, p.Kind)
+	assert.Equal(t, 3, p.Issues)
+	assert.Equal(t, 0.0, p.Estimated)
+	assert.Equal(t, 0, p.Unestimated)
+	assert.Equal(t, 1.0/3.0, p.Ratio())
+
+	assert.Equal(t, []string{"status,timeoriginalestimate", "status"}, fields)
+}
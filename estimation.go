@@ -0,0 +1,297 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EstimateKind represents what a board uses to estimate issues
+type EstimateKind string
+
+// Kinds of estimation used by the boards
+const (
+	EstimateNone       EstimateKind = "none"
+	EstimateTime       EstimateKind = "time"
+	EstimatePoints     EstimateKind = "points"
+	EstimateIssueCount EstimateKind = "issueCount"
+)
+
+// timeTrackingFields are the fields that store an estimation as a number of seconds
+var timeTrackingFields = map[string]bool{
+	"timeoriginalestimate":          true,
+	"timeestimate":                  true,
+	"aggregatetimeoriginalestimate": true,
+	"aggregatetimeestimate":         true,
+}
+
+// Kind returns what the board uses to estimate issues. Boards that estimate using
+// a field return EstimateTime for the time tracking fields and EstimatePoints for
+// any other field, e.g. Story Points.
+func (e ConfigurationEstimation) Kind() EstimateKind {
+	switch e.Type {
+	case "issueCount":
+		return EstimateIssueCount
+	case "field":
+		if timeTrackingFields[e.Field.ID] {
+			return EstimateTime
+		}
+		return EstimatePoints
+	}
+	return EstimateNone
+}
+
+// DurationFormat contains the settings used to parse and format Jira durations
+// like "1w 2d 3h 20m". They must match the time tracking settings of the Jira instance.
+type DurationFormat struct {
+	HoursPerDay float64
+	DaysPerWeek float64
+	//The unit of a number without unit, w, d, h or m, as the default unit of the time
+	//tracking settings. A number without unit is invalid when empty.
+	DefaultUnit string
+}
+
+// DefaultDurationFormat is the default time tracking setting of Jira: 8 hours per day
+// and 5 days per week.
+var DefaultDurationFormat = &DurationFormat{HoursPerDay: 8, DaysPerWeek: 5}
+
+func (f *DurationFormat) units() []struct {
+	Symbol string
+	Size   time.Duration
+} {
+	if f == nil {
+		f = DefaultDurationFormat
+	}
+
+	day := time.Duration(f.HoursPerDay * float64(time.Hour))
+	return []struct {
+		Symbol string
+		Size   time.Duration
+	}{
+		{"w", time.Duration(f.DaysPerWeek * float64(day))},
+		{"d", day},
+		{"h", time.Hour},
+		{"m", time.Minute},
+	}
+}
+
+// Parse parses a Jira duration like "1w 2d 3h 20m" or "1.5h". A number without unit is
+// in the default unit of the format, it is invalid when the format has none.
+func (f *DurationFormat) Parse(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("jira: invalid duration %q", s)
+	}
+
+	units := f.units()
+	var total float64
+
+	rest := s
+	for rest != "" {
+		rest = strings.TrimLeft(rest, " ")
+
+		i := 0
+		for i < len(rest) && (rest[i] == '.' || ('0' <= rest[i] && rest[i] <= '9')) {
+			i++
+		}
+
+		value, err := strconv.ParseFloat(rest[:i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("jira: invalid duration %q", s)
+		}
+		rest = rest[i:]
+
+		if rest == "" {
+			size := f.unitSize(units)
+			if size == 0 {
+				return 0, fmt.Errorf("jira: duration %q has no unit", s)
+			}
+			total += value * float64(size)
+			break
+		}
+
+		found := false
+		for _, u := range units {
+			if strings.HasPrefix(rest, u.Symbol) {
+				total += value * float64(u.Size)
+				rest = rest[len(u.Symbol):]
+				found = true
+				break
+			}
+		}
+
+		if !found || (rest != "" && rest[0] != ' ' && (rest[0] < '0' || rest[0] > '9')) {
+			return 0, fmt.Errorf("jira: invalid duration %q", s)
+		}
+	}
+
+	return time.Duration(math.Round(total)), nil
+}
+
+// unitSize returns the size of the default unit, 0 when the format has none
+func (f *DurationFormat) unitSize(units []struct {
+	Symbol string
+	Size   time.Duration
+}) time.Duration {
+	if f == nil {
+		return 0
+	}
+	for _, u := range units {
+		if u.Symbol == f.DefaultUnit {
+			return u.Size
+		}
+	}
+	return 0
+}
+
+// Format formats the duration as Jira does, e.g. "1w 2d 3h 20m". Seconds are discarded.
+func (f *DurationFormat) Format(d time.Duration) string {
+	if d < time.Minute {
+		return "0m"
+	}
+
+	var parts []string
+	for _, u := range f.units() {
+		if u.Size <= 0 {
+			continue
+		}
+		if n := d / u.Size; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", n, u.Symbol))
+			d -= n * u.Size
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Estimate represents the estimation of an issue on a board. Value is a number of
+// seconds when the board estimates using time and a number of points otherwise.
+type Estimate struct {
+	Kind    EstimateKind
+	FieldID string
+	Value   float64
+}
+
+// NewEstimate returns the estimate of an issue, interpreting the raw estimation
+// according to the estimation configuration of the board.
+func NewEstimate(config ConfigurationEstimation, estimation *IssueEstimation) *Estimate {
+	e := &Estimate{
+		Kind:    config.Kind(),
+		FieldID: config.Field.ID,
+	}
+
+	if estimation != nil {
+		e.Value = estimation.Value
+		if estimation.FieldID != "" {
+			e.FieldID = estimation.FieldID
+		}
+	}
+
+	return e
+}
+
+// ParseEstimate parses an estimate of the given kind. Time estimates are parsed
+// as Jira durations using the format, points are parsed as decimal numbers.
+func ParseEstimate(kind EstimateKind, s string, f *DurationFormat) (*Estimate, error) {
+	switch kind {
+	case EstimateTime:
+		d, err := f.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+		return &Estimate{Kind: kind, Value: d.Seconds()}, nil
+	case EstimatePoints:
+		v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, fmt.Errorf("jira: invalid points estimate %q", s)
+		}
+		return &Estimate{Kind: kind, Value: v}, nil
+	}
+
+	return nil, fmt.Errorf("jira: issues cannot be estimated on boards using %q estimation", kind)
+}
+
+// Duration returns the time estimate as a time.Duration
+func (e *Estimate) Duration() time.Duration {
+	return time.Duration(math.Round(e.Value * float64(time.Second)))
+}
+
+// Format returns the estimate as a human-readable string, time estimates are formatted
+// as Jira durations using the format.
+func (e *Estimate) Format(f *DurationFormat) string {
+	if e.Kind == EstimateTime {
+		return f.Format(e.Duration())
+	}
+	return strconv.FormatFloat(e.Value, 'f', -1, 64)
+}
+
+func (e *Estimate) String() string {
+	return e.Format(DefaultDurationFormat)
+}
+
+// value returns the estimate as expected by the estimation resource. Time estimates are
+// sent in minutes with an explicit unit, e.g. 200m: Jira reads a number without unit in
+// the default unit of its time tracking settings, and days and weeks depend on them.
+func (e *Estimate) value() string {
+	if e.Kind == EstimateTime {
+		return strconv.FormatFloat(math.Round(e.Value/60), 'f', -1, 64) + "m"
+	}
+	return strconv.FormatFloat(e.Value, 'f', -1, 64)
+}
+
+// GetEstimate returns the estimation of the issue for the board, typed according to the
+// board's estimation configuration (see BoardsService.GetConfiguration).
+//
+// GET /rest/agile/1.0/board/{boardId}/configuration
+// GET /rest/agile/1.0/issue/{issueIdOrKey}/estimation
+func (i *IssuesService) GetEstimate(ctx context.Context, idOrKey string, boardID int) (*Estimate, *Response, error) {
+
+	config, resp, err := i.client.Boards.GetConfiguration(ctx, boardID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	kind := config.Estimation.Kind()
+	if kind == EstimateNone || kind == EstimateIssueCount {
+		return &Estimate{Kind: kind}, resp, nil
+	}
+
+	estimation, resp, err := i.GetEstimationForBoard(ctx, idOrKey, boardID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return NewEstimate(config.Estimation, estimation), resp, nil
+}
+
+// SetEstimate updates the estimation of the issue for the board. The kind of the estimate
+// must match the board's estimation configuration (see BoardsService.GetConfiguration),
+// an error is returned otherwise.
+//
+// GET /rest/agile/1.0/board/{boardId}/configuration
+// PUT /rest/agile/1.0/issue/{issueIdOrKey}/estimation
+func (i *IssuesService) SetEstimate(ctx context.Context, idOrKey string, boardID int, estimate *Estimate) (*Estimate, *Response, error) {
+
+	config, resp, err := i.client.Boards.GetConfiguration(ctx, boardID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	kind := config.Estimation.Kind()
+	if kind != EstimateTime && kind != EstimatePoints {
+		return nil, resp, fmt.Errorf("jira: issues cannot be estimated on board %d, it uses %q estimation", boardID, kind)
+	}
+	if kind != estimate.Kind {
+		return nil, resp, fmt.Errorf("jira: board %d uses %q estimation, got a %q estimate", boardID, kind, estimate.Kind)
+	}
+
+	estimation, resp, err := i.EstimationForBoard(ctx, idOrKey, boardID, estimate.value())
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return NewEstimate(config.Estimation, estimation), resp, nil
+}
@@ -0,0 +1,146 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigurationEstimationKind(t *testing.T) {
+	tests := []struct {
+		Estimation ConfigurationEstimation
+		Kind       EstimateKind
+	}{
+		{ConfigurationEstimation{Type: "field", Field: ConfigurationEstimationField{ID: "timeoriginalestimate"}}, EstimateTime},
+		{ConfigurationEstimation{Type: "field", Field: ConfigurationEstimationField{ID: "customfield_10002"}}, EstimatePoints},
+		{ConfigurationEstimation{Type: "issueCount"}, EstimateIssueCount},
+		{ConfigurationEstimation{Type: "none"}, EstimateNone},
+		{ConfigurationEstimation{}, EstimateNone},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.Kind, tt.Estimation.Kind())
+	}
+}
+
+func TestDurationFormatParse(t *testing.T) {
+	sixHours := &DurationFormat{HoursPerDay: 6, DaysPerWeek: 4}
+
+	tests := []struct {
+		Format *DurationFormat
+		Input  string
+		Want   time.Duration
+		Err    bool
+	}{
+		{Format: DefaultDurationFormat, Input: "1w 2d 3h 20m", Want: (40+16+3)*time.Hour + 20*time.Minute},
+		{Format: DefaultDurationFormat, Input: "1w2d", Want: 56 * time.Hour},
+		{Format: DefaultDurationFormat, Input: "1.5h", Want: 90 * time.Minute},
+		{Format: DefaultDurationFormat, Input: "90", Err: true},
+		{Format: &DurationFormat{HoursPerDay: 8, DaysPerWeek: 5, DefaultUnit: "m"}, Input: "90", Want: 90 * time.Minute},
+		{Format: &DurationFormat{HoursPerDay: 8, DaysPerWeek: 5, DefaultUnit: "h"}, Input: "90", Want: 90 * time.Hour},
+		{Format: &DurationFormat{HoursPerDay: 8, DaysPerWeek: 5, DefaultUnit: "h"}, Input: "1d 2", Want: 10 * time.Hour},
+		{Format: nil, Input: "1d", Want: 8 * time.Hour},
+		{Format: sixHours, Input: "1w 1d", Want: 30 * time.Hour},
+		{Format: DefaultDurationFormat, Input: "", Err: true},
+		{Format: DefaultDurationFormat, Input: "3x", Err: true},
+		{Format: DefaultDurationFormat, Input: "3hours", Err: true},
+		{Format: DefaultDurationFormat, Input: "h", Err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Input, func(t *testing.T) {
+			d, err := tt.Format.Parse(tt.Input)
+			if tt.Err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.Want, d)
+		})
+	}
+}
+
+func TestDurationFormatFormat(t *testing.T) {
+	assert.Equal(t, "1w 2d 3h 20m", DefaultDurationFormat.Format(59*time.Hour+20*time.Minute))
+	assert.Equal(t, "0m", DefaultDurationFormat.Format(30*time.Second))
+	assert.Equal(t, "1d 1h", DefaultDurationFormat.Format(9*time.Hour))
+
+	sixHours := &DurationFormat{HoursPerDay: 6, DaysPerWeek: 4}
+	assert.Equal(t, "1w 1d", sixHours.Format(30*time.Hour))
+
+	d, err := sixHours.Parse(sixHours.Format(47*time.Hour + 5*time.Minute))
+	assert.Nil(t, err)
+	assert.Equal(t, 47*time.Hour+5*time.Minute, d)
+}
+
+func TestParseEstimate(t *testing.T) {
+	e, err := ParseEstimate(EstimatePoints, "0.5", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.5, e.Value)
+	assert.Equal(t, "0.5", e.String())
+
+	e, err = ParseEstimate(EstimateTime, "1d 2h", DefaultDurationFormat)
+	assert.Nil(t, err)
+	assert.Equal(t, 36000.0, e.Value)
+	assert.Equal(t, 10*time.Hour, e.Duration())
+	assert.Equal(t, "1d 2h", e.String())
+	assert.Equal(t, "600m", e.value())
+
+	_, err = ParseEstimate(EstimatePoints, "abc", nil)
+	assert.NotNil(t, err)
+
+	_, err = ParseEstimate(EstimateIssueCount, "1", nil)
+	assert.NotNil(t, err)
+}
+
+func TestIssuesServiceGetEstimate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/84/configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 84,"estimation": {"type": "field","field": {"fieldId": "customfield_10002","displayName": "Story Points"}}}`)
+	})
+	mux.HandleFunc("/board/85/configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 85,"estimation": {"type": "issueCount"}}`)
+	})
+	mux.HandleFunc("/issue/MCP-1/estimation", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "84", r.URL.Query().Get("boardId"))
+		fmt.Fprint(w, `{"fieldId": "customfield_10002","value": 0.5}`)
+	})
+
+	estimate, _, err := client.Issues.GetEstimate(context.Background(), "MCP-1", 84)
+	assert.Nil(t, err)
+	assert.Equal(t, &Estimate{Kind: EstimatePoints, FieldID: "customfield_10002", Value: 0.5}, estimate)
+
+	estimate, _, err = client.Issues.GetEstimate(context.Background(), "MCP-1", 85)
+	assert.Nil(t, err)
+	assert.Equal(t, EstimateIssueCount, estimate.Kind)
+}
+
+func TestIssuesServiceSetEstimate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/84/configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 84,"estimation": {"type": "field","field": {"fieldId": "timeoriginalestimate","displayName": "Original Time Estimate"}}}`)
+	})
+	mux.HandleFunc("/issue/MCP-1/estimation", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"value": "200m"}`, string(body))
+		fmt.Fprint(w, `{"fieldId": "timeoriginalestimate","value": 12000}`)
+	})
+
+	e, _ := ParseEstimate(EstimateTime, "3h 20m", nil)
+	estimate, _, err := client.Issues.SetEstimate(context.Background(), "MCP-1", 84, e)
+	assert.Nil(t, err)
+	assert.Equal(t, "3h 20m", estimate.String())
+
+	_, _, err = client.Issues.SetEstimate(context.Background(), "MCP-1", 84, &Estimate{Kind: EstimatePoints, Value: 3})
+	assert.NotNil(t, err)
+}
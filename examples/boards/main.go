@@ -12,16 +12,11 @@ import (
 )
 
 var (
-	url, user, pass string
-	write           bool
-	err             error
+	write bool
+	err   error
 )
 
 func init() {
-	url = os.Getenv("JIRA_URL")
-	user = os.Getenv("JIRA_USER")
-	pass = os.Getenv("JIRA_PASS")
-
 	writeStr := os.Getenv("JIRA_WRITE_SRV")
 	if writeStr == "" {
 		writeStr = "false"
@@ -35,11 +30,7 @@ func init() {
 
 func main() {
 
-	tp := &jira.BasicAuthTransport{
-		Username: user,
-		Password: pass,
-	}
-	client, err := jira.NewClient(url, tp.Client())
+	client, err := jira.NewClientFromProfile("")
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -222,8 +213,12 @@ func listProjects(client *jira.Client) {
 	}
 
 	for _, p := range projects {
+		category := ""
+		if p.Category != nil {
+			category = p.Category.Name
+		}
 		fmt.Printf("\tid: %s, key: %s, category: %v\n",
-			p.ID, p.Key, p.Category)
+			p.ID, p.Key, category)
 	}
 }
 
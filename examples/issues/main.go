@@ -11,16 +11,11 @@ import (
 )
 
 var (
-	url, user, pass string
-	write           bool
-	err             error
+	write bool
+	err   error
 )
 
 func init() {
-	url = os.Getenv("JIRA_URL")
-	user = os.Getenv("JIRA_USER")
-	pass = os.Getenv("JIRA_PASS")
-
 	writeStr := os.Getenv("JIRA_WRITE_SRV")
 	if writeStr == "" {
 		writeStr = "false"
@@ -34,11 +29,7 @@ func init() {
 
 func main() {
 
-	tp := &jira.BasicAuthTransport{
-		Username: user,
-		Password: pass,
-	}
-	client, err := jira.NewClient(url, tp.Client())
+	client, err := jira.NewClientFromProfile("")
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -68,7 +59,7 @@ func getIssueEstimationForBoard(client *jira.Client) {
 		log.Fatal(err)
 	}
 
-	fmt.Printf("\t%s - %d\n", issueEst.FieldID, issueEst.Value)
+	fmt.Printf("\t%s - %v\n", issueEst.FieldID, issueEst.Value)
 }
 
 func issueEstimationForBoard(client *jira.Client) {
@@ -78,7 +69,7 @@ func issueEstimationForBoard(client *jira.Client) {
 		log.Fatal(err)
 	}
 
-	fmt.Printf("\t%s - %d\n", issueEst.FieldID, issueEst.Value)
+	fmt.Printf("\t%s - %v\n", issueEst.FieldID, issueEst.Value)
 }
 
 func rank(client *jira.Client) {
@@ -3,6 +3,8 @@ module github.com/leocomelli/jira
 go 1.12
 
 require (
+	github.com/BurntSushi/toml v1.2.1
 	github.com/fatih/structs v1.1.0
 	github.com/stretchr/testify v1.9.0
+	gopkg.in/yaml.v3 v3.0.1
 )
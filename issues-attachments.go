@@ -0,0 +1,190 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// AttachmentFile is a file to attach to an issue, read from Content when it is uploaded
+type AttachmentFile struct {
+	Name    string
+	Content io.Reader
+}
+
+// AttachmentSettings contains the attachment settings of Jira
+type AttachmentSettings struct {
+	Enabled bool `json:"enabled"`
+	//The maximum size of an attachment, in bytes.
+	UploadLimit int64 `json:"uploadLimit,omitempty"`
+}
+
+// SizeError is returned by DownloadAttachment when the size of the downloaded content
+// differs from the size of the attachment
+type SizeError struct {
+	Attachment string
+	Expected   int64
+	Written    int64
+}
+
+func (e *SizeError) Error() string {
+	return fmt.Sprintf("jira: attachment %s has %d bytes, %d were written", e.Attachment, e.Expected, e.Written)
+}
+
+// AddAttachments uploads the files and attaches them to the issue. The files are streamed
+// in a multipart request, they are not buffered in memory.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/attachments
+func (i *IssuesService) AddAttachments(ctx context.Context, idOrKey string, files ...*AttachmentFile) ([]*IssueAttachment, *Response, error) {
+
+	req, err := i.client.NewRequest("POST", fmt.Sprintf("%sissue/%s/attachments", apiPath, idOrKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+
+	mw := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeAttachments(mw, files))
+	}()
+
+	req.Body = pr
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	// required by Jira to accept the request without a XSRF token
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	var attachments []*IssueAttachment
+	resp, err := i.client.Do(ctx, req, &attachments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return attachments, resp, nil
+}
+
+// writeAttachments writes the files as the parts of a multipart body
+func writeAttachments(mw *multipart.Writer, files []*AttachmentFile) error {
+	for _, file := range files {
+		part, err := mw.CreateFormFile("file", file.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(part, file.Content); err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
+
+// GetAttachment returns the metadata of an attachment.
+//
+// GET /rest/api/2/attachment/{id}
+func (i *IssuesService) GetAttachment(ctx context.Context, attachmentID string) (*IssueAttachment, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sattachment/%s", apiPath, attachmentID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var attachment = &IssueAttachment{}
+	resp, err := i.client.Do(ctx, req, attachment)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return attachment, resp, nil
+}
+
+// GetAttachmentSettings returns whether attachments are enabled and their maximum size.
+//
+// GET /rest/api/2/attachment/meta
+func (i *IssuesService) GetAttachmentSettings(ctx context.Context) (*AttachmentSettings, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", apiPath+"attachment/meta", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var settings = &AttachmentSettings{}
+	resp, err := i.client.Do(ctx, req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
+
+// countingWriter counts the bytes written and keeps the first write error
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	if err != nil && c.err == nil {
+		c.err = err
+	}
+	return n, err
+}
+
+// DownloadAttachment streams the content of the attachment to w and returns the number of
+// bytes written. A *SizeError is returned when the number of bytes written differs from
+// the size of the attachment, e.g. when the download was interrupted. Use GetAttachment to
+// get an attachment by id.
+//
+// GET {attachment content}
+func (i *IssuesService) DownloadAttachment(ctx context.Context, attachment *IssueAttachment, w io.Writer) (int64, *Response, error) {
+
+	if attachment.Content == "" {
+		return 0, nil, fmt.Errorf("jira: attachment %s has no content link", attachment.ID)
+	}
+
+	req, err := i.client.NewRequest("GET", attachment.Content, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	cw := &countingWriter{w: w}
+	resp, err := i.client.Do(ctx, req, cw)
+	if err != nil {
+		return cw.n, resp, err
+	}
+	if cw.err != nil {
+		return cw.n, resp, cw.err
+	}
+
+	if cw.n != int64(attachment.Size) {
+		return cw.n, resp, &SizeError{Attachment: attachment.ID, Expected: int64(attachment.Size), Written: cw.n}
+	}
+
+	return cw.n, resp, nil
+}
+
+// DeleteAttachment deletes an attachment.
+//
+// DELETE /rest/api/2/attachment/{id}
+func (i *IssuesService) DeleteAttachment(ctx context.Context, attachmentID string) (bool, *Response, error) {
+
+	req, err := i.client.NewRequest("DELETE", fmt.Sprintf("%sattachment/%s", apiPath, attachmentID), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
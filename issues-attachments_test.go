@@ -0,0 +1,107 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuesServiceAddAttachments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "no-check", r.Header.Get("X-Atlassian-Token"))
+
+		reader, err := r.MultipartReader()
+		assert.Nil(t, err)
+
+		var names, contents []string
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				break
+			}
+			assert.Equal(t, "file", part.FormName())
+			data, _ := ioutil.ReadAll(part)
+			names = append(names, part.FileName())
+			contents = append(contents, string(data))
+		}
+		assert.Equal(t, []string{"a.txt", "b.log"}, names)
+		assert.Equal(t, []string{"first", "second"}, contents)
+
+		fmt.Fprint(w, `[{"id": "10000","filename": "a.txt","size": 5},{"id": "10001","filename": "b.log","size": 6}]`)
+	})
+
+	attachments, _, err := client.Issues.AddAttachments(context.Background(), "MCP-1",
+		&AttachmentFile{Name: "a.txt", Content: strings.NewReader("first")},
+		&AttachmentFile{Name: "b.log", Content: strings.NewReader("second")})
+	assert.Nil(t, err)
+	assert.Len(t, attachments, 2)
+	assert.Equal(t, "10001", attachments[1].ID)
+}
+
+func TestIssuesServiceGetDeleteAttachment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/attachment/10000", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id": "10000","filename": "a.txt","size": 5,"mimeType": "text/plain",
+				"content": "https://jira.com/rest/api/2/attachment/content/10000"}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	mux.HandleFunc("/api/2/attachment/meta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"enabled": true,"uploadLimit": 1000000}`)
+	})
+
+	attachment, _, err := client.Issues.GetAttachment(context.Background(), "10000")
+	assert.Nil(t, err)
+	assert.Equal(t, "text/plain", attachment.MimeType)
+	assert.Equal(t, 5, attachment.Size)
+
+	settings, _, err := client.Issues.GetAttachmentSettings(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, &AttachmentSettings{Enabled: true, UploadLimit: 1000000}, settings)
+
+	deleted, _, err := client.Issues.DeleteAttachment(context.Background(), "10000")
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
+
+func TestIssuesServiceDownloadAttachment(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/attachment/content/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, "first")
+	})
+
+	attachment := &IssueAttachment{ID: "10000", Size: 5, Content: serverURL + "/api/2/attachment/content/10000"}
+
+	var buf bytes.Buffer
+	n, _, err := client.Issues.DownloadAttachment(context.Background(), attachment, &buf)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), n)
+	assert.Equal(t, "first", buf.String())
+
+	attachment.Size = 10
+	buf.Reset()
+	_, _, err = client.Issues.DownloadAttachment(context.Background(), attachment, &buf)
+	assert.Equal(t, &SizeError{Attachment: "10000", Expected: 10, Written: 5}, err)
+
+	_, _, err = client.Issues.DownloadAttachment(context.Background(), &IssueAttachment{ID: "10001"}, &buf)
+	assert.EqualError(t, err, "jira: attachment 10001 has no content link")
+}
@@ -0,0 +1,154 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Visibility types of a comment
+const (
+	VisibilityRole  = "role"
+	VisibilityGroup = "group"
+)
+
+// PublicCommentProperty is the property of a comment that makes it internal in Jira
+// Service Desk, when its value is {"internal": true}
+const PublicCommentProperty = "sd.public.comment"
+
+// CommentRequest contains the fields of a comment to add or update
+type CommentRequest struct {
+	Body string `json:"body"`
+	//Restricts the comment to a role or a group, visible to all users when nil.
+	Visibility *CommentVisibility `json:"visibility,omitempty"`
+	//Properties of the comment, e.g. PublicCommentProperty.
+	Properties []*Property `json:"properties,omitempty"`
+}
+
+// CommentsOptions contains all options to list the comments of an issue
+type CommentsOptions struct {
+	//The starting index of the returned comments. Base index: 0.
+	StartAt int `query:"startAt"`
+	//The maximum number of comments to return per page. Default: 50.
+	MaxResults int `query:"maxResults"`
+	//Ordering of the results, created or -created.
+	OrderBy string `query:"orderBy"`
+	//Use renderedBody to return the comments body rendered in HTML.
+	Expand string `query:"expand"`
+}
+
+// CommentOptions contains the options to get, add or update a comment
+type CommentOptions struct {
+	//Use renderedBody to return the comment body rendered in HTML.
+	Expand string `query:"expand"`
+}
+
+// ListComments returns a page of the comments of the issue.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/comment
+func (i *IssuesService) ListComments(ctx context.Context, idOrKey string, opts *CommentsOptions) ([]*IssueComment, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sissue/%s/comment%s", apiPath, idOrKey, q), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &IssueCommentWrap{}
+	resp, err := i.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.MaxResults = wrap.MaxResults
+	resp.StartAt = wrap.StartAt
+	resp.IsLast = wrap.StartAt+len(wrap.Comments) >= wrap.Total
+
+	return wrap.Comments, resp, nil
+}
+
+// GetComment returns a comment of the issue.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/comment/{id}
+func (i *IssuesService) GetComment(ctx context.Context, idOrKey string, commentID string, opts *CommentOptions) (*IssueComment, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sissue/%s/comment/%s%s", apiPath, idOrKey, commentID, q), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var comment = &IssueComment{}
+	resp, err := i.client.Do(ctx, req, comment)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return comment, resp, nil
+}
+
+// AddComment adds a comment to the issue.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/comment
+func (i *IssuesService) AddComment(ctx context.Context, idOrKey string, comment *CommentRequest, opts *CommentOptions) (*IssueComment, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("POST", fmt.Sprintf("%sissue/%s/comment%s", apiPath, idOrKey, q), comment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var added = &IssueComment{}
+	resp, err := i.client.Do(ctx, req, added)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return added, resp, nil
+}
+
+// UpdateComment updates a comment of the issue.
+//
+// PUT /rest/api/2/issue/{issueIdOrKey}/comment/{id}
+func (i *IssuesService) UpdateComment(ctx context.Context, idOrKey string, commentID string, comment *CommentRequest, opts *CommentOptions) (*IssueComment, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("PUT", fmt.Sprintf("%sissue/%s/comment/%s%s", apiPath, idOrKey, commentID, q), comment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated = &IssueComment{}
+	resp, err := i.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, nil
+}
+
+// DeleteComment deletes a comment of the issue.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}/comment/{id}
+func (i *IssuesService) DeleteComment(ctx context.Context, idOrKey string, commentID string) (bool, *Response, error) {
+
+	req, err := i.client.NewRequest("DELETE", fmt.Sprintf("%sissue/%s/comment/%s", apiPath, idOrKey, commentID), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
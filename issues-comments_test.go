@@ -0,0 +1,101 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const commentJSON = `{"self": "http://www.example.com/jira/rest/api/2/issue/10010/comment/10000","id": "10000",
+	"author": {"name": "fred","displayName": "Fred F. User"},"body": "Lorem ipsum","renderedBody": "<p>Lorem ipsum</p>",
+	"visibility": {"type": "role","value": "Administrators"},"jsdPublic": true,
+	"properties": [{"key": "sd.public.comment","value": {"internal": false}}]}`
+
+func TestIssuesServiceListComments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "-created", r.URL.Query().Get("orderBy"))
+		assert.Equal(t, "renderedBody", r.URL.Query().Get("expand"))
+		fmt.Fprintf(w, `{"startAt": 0,"maxResults": 1,"total": 2,"comments": [%s]}`, commentJSON)
+	})
+
+	opts := &CommentsOptions{MaxResults: 1, OrderBy: "-created", Expand: "renderedBody"}
+	comments, resp, err := client.Issues.ListComments(context.Background(), "MCP-1", opts)
+	assert.Nil(t, err)
+	assert.False(t, resp.IsLast)
+	assert.Len(t, comments, 1)
+
+	comment := comments[0]
+	assert.Equal(t, "<p>Lorem ipsum</p>", comment.RenderedBody)
+	assert.Equal(t, &CommentVisibility{Type: VisibilityRole, Value: "Administrators"}, comment.Visibility)
+	assert.True(t, comment.Public)
+	assert.Equal(t, PublicCommentProperty, comment.Properties[0].Key)
+}
+
+func TestIssuesServiceGetComment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/comment/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, commentJSON)
+	})
+
+	comment, _, err := client.Issues.GetComment(context.Background(), "MCP-1", "10000", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", comment.Author.Name)
+}
+
+func TestIssuesServiceAddUpdateDeleteComment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{
+			"body":       "Lorem ipsum",
+			"visibility": map[string]interface{}{"type": "group", "value": "jira-developers"},
+			"properties": []interface{}{map[string]interface{}{"key": "sd.public.comment", "value": map[string]interface{}{"internal": true}}},
+		}, body)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, commentJSON)
+	})
+
+	mux.HandleFunc("/api/2/issue/MCP-1/comment/10000", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			assert.Equal(t, "renderedBody", r.URL.Query().Get("expand"))
+			body := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, map[string]interface{}{"body": "Updated"}, body)
+			fmt.Fprint(w, commentJSON)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	request := &CommentRequest{
+		Body:       "Lorem ipsum",
+		Visibility: &CommentVisibility{Type: VisibilityGroup, Value: "jira-developers"},
+		Properties: []*Property{{Key: PublicCommentProperty, Value: json.RawMessage(`{"internal":true}`)}},
+	}
+	comment, _, err := client.Issues.AddComment(context.Background(), "MCP-1", request, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "10000", comment.ID)
+
+	_, _, err = client.Issues.UpdateComment(context.Background(), "MCP-1", "10000", &CommentRequest{Body: "Updated"}, &CommentOptions{Expand: "renderedBody"})
+	assert.Nil(t, err)
+
+	deleted, _, err := client.Issues.DeleteComment(context.Background(), "MCP-1", "10000")
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
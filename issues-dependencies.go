@@ -0,0 +1,288 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// dependencyFields are the fields of the issues of a dependency graph
+const dependencyFields = "summary,status,issuelinks"
+
+// DependencyGraphOptions contains the options to build a dependency graph
+type DependencyGraphOptions struct {
+	//The name of the link type followed, BlocksLinkType by default.
+	LinkType string
+	//How many links away from the starting issues the graph goes. Only the links between
+	//the starting issues are followed when 0.
+	Depth int
+}
+
+// DependencyNode is an issue of a dependency graph
+type DependencyNode struct {
+	Issue *Issue
+	//The number of links between the issue and the closest starting issue.
+	Depth int
+	//The keys of the issues blocked by this issue, and of the issues blocking it.
+	Blocks    []string
+	BlockedBy []string
+}
+
+// DependencyGraph contains issues and the blocks/is blocked by links between them
+type DependencyGraph struct {
+	Nodes map[string]*DependencyNode
+	//The keys of the issues, starting issues first, in the order they were added.
+	Keys []string
+	// the links already added, by blocking and blocked issue keys
+	edges map[[2]string]bool
+}
+
+// CycleError is returned by TopologicalOrder when issues block each other
+type CycleError struct {
+	Cycles [][]string
+}
+
+func (e *CycleError) Error() string {
+	cycles := make([]string, len(e.Cycles))
+	for n, cycle := range e.Cycles {
+		cycles[n] = strings.Join(cycle, ", ")
+	}
+	return fmt.Sprintf("jira: issues block each other: [%s]", strings.Join(cycles, "], ["))
+}
+
+func newDependencyGraph() *DependencyGraph {
+	return &DependencyGraph{Nodes: map[string]*DependencyNode{}, edges: map[[2]string]bool{}}
+}
+
+func (g *DependencyGraph) add(issue *Issue, depth int) {
+	g.Nodes[issue.Key] = &DependencyNode{Issue: issue, Depth: depth}
+	g.Keys = append(g.Keys, issue.Key)
+}
+
+func (g *DependencyGraph) link(blocking, blocked string) {
+	edge := [2]string{blocking, blocked}
+	if g.edges[edge] {
+		return
+	}
+	g.edges[edge] = true
+	g.Nodes[blocking].Blocks = append(g.Nodes[blocking].Blocks, blocked)
+	g.Nodes[blocked].BlockedBy = append(g.Nodes[blocked].BlockedBy, blocking)
+}
+
+// Cycles returns the groups of issues that block each other, directly or not, each one
+// sorted by key.
+func (g *DependencyGraph) Cycles() [][]string {
+	// Tarjan's strongly connected components
+	index := map[string]int{}
+	low := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var cycles [][]string
+
+	var visit func(key string)
+	visit = func(key string) {
+		index[key] = len(index)
+		low[key] = index[key]
+		stack = append(stack, key)
+		onStack[key] = true
+
+		for _, next := range g.Nodes[key].Blocks {
+			if _, ok := index[next]; !ok {
+				visit(next)
+				if low[next] < low[key] {
+					low[key] = low[next]
+				}
+			} else if onStack[next] && index[next] < low[key] {
+				low[key] = index[next]
+			}
+		}
+
+		if low[key] != index[key] {
+			return
+		}
+
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == key {
+				break
+			}
+		}
+		if len(component) > 1 || g.edges[[2]string{key, key}] {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
+	}
+
+	for _, key := range g.Keys {
+		if _, ok := index[key]; !ok {
+			visit(key)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0] < cycles[j][0] })
+	return cycles
+}
+
+// TopologicalOrder returns the keys of the issues, each issue after the issues blocking
+// it. Issues that do not depend on each other keep the order of Keys. A *CycleError is
+// returned when issues block each other.
+func (g *DependencyGraph) TopologicalOrder() ([]string, error) {
+	if cycles := g.Cycles(); len(cycles) > 0 {
+		return nil, &CycleError{Cycles: cycles}
+	}
+
+	position := make(map[string]int, len(g.Keys))
+	blockers := make(map[string]int, len(g.Keys))
+	for n, key := range g.Keys {
+		position[key] = n
+		blockers[key] = len(g.Nodes[key].BlockedBy)
+	}
+
+	var ready []string
+	for _, key := range g.Keys {
+		if blockers[key] == 0 {
+			ready = append(ready, key)
+		}
+	}
+
+	order := make([]string, 0, len(g.Keys))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool { return position[ready[i]] < position[ready[j]] })
+		key := ready[0]
+		ready = ready[1:]
+		order = append(order, key)
+
+		for _, blocked := range g.Nodes[key].Blocks {
+			blockers[blocked]--
+			if blockers[blocked] == 0 {
+				ready = append(ready, blocked)
+			}
+		}
+	}
+
+	return order, nil
+}
+
+// DependencyGraph returns the graph of the blocks/is blocked by links of the issues, the
+// links of another type when set in the options. The issues must have their issuelinks
+// field. Linked issues are added up to the depth defined in the options, they are
+// fetched to follow their own links.
+//
+// GET /rest/agile/1.0/issue/{issueIdOrKey}
+func (i *IssuesService) DependencyGraph(ctx context.Context, issues []*Issue, opts *DependencyGraphOptions) (*DependencyGraph, *Response, error) {
+
+	o := DependencyGraphOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.LinkType == "" {
+		o.LinkType = BlocksLinkType
+	}
+
+	graph := newDependencyGraph()
+	for _, issue := range issues {
+		if _, ok := graph.Nodes[issue.Key]; !ok {
+			graph.add(issue, 0)
+		}
+	}
+
+	var resp *Response
+	for n := 0; n < len(graph.Keys); n++ {
+		node := graph.Nodes[graph.Keys[n]]
+
+		// linked issues only have a few fields, they are fetched to get their links
+		if node.Depth > 0 {
+			issue, r, err := i.Get(ctx, node.Issue.Key, &GetIssueOptions{Fields: dependencyFields})
+			resp = r
+			if err != nil {
+				return nil, resp, err
+			}
+			node.Issue = issue
+		}
+		if node.Issue.Fields == nil {
+			continue
+		}
+
+		for _, link := range node.Issue.Fields.Links {
+			if link.Type == nil || !strings.EqualFold(link.Type.Name, o.LinkType) {
+				continue
+			}
+
+			other := link.Outward
+			if other == nil {
+				other = link.Inward
+			}
+			if other == nil {
+				continue
+			}
+
+			if _, ok := graph.Nodes[other.Key]; !ok {
+				if node.Depth >= o.Depth {
+					continue
+				}
+				graph.add(other, node.Depth+1)
+			}
+
+			if link.Outward != nil {
+				graph.link(node.Issue.Key, other.Key)
+			} else {
+				graph.link(other.Key, node.Issue.Key)
+			}
+		}
+	}
+
+	return graph, resp, nil
+}
+
+// DependencyGraph returns the dependency graph of the issues of the sprint (see
+// IssuesService.DependencyGraph).
+//
+// GET /rest/agile/1.0/sprint/{sprintId}/issue
+// GET /rest/agile/1.0/issue/{issueIdOrKey}
+func (s *SprintsService) DependencyGraph(ctx context.Context, sprintID int, opts *DependencyGraphOptions) (*DependencyGraph, *Response, error) {
+
+	list := func(ctx context.Context, o *IssuesOptions) ([]*Issue, *Response, error) {
+		return s.ListIssues(ctx, sprintID, o)
+	}
+
+	issues, resp, err := listAllIssues(ctx, &IssuesOptions{Fields: dependencyFields}, list)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	graph, graphResp, err := s.client.Issues.DependencyGraph(ctx, issues, opts)
+	if graphResp != nil {
+		resp = graphResp
+	}
+
+	return graph, resp, err
+}
+
+// DependencyGraph returns the dependency graph of the issues of the epic (see
+// IssuesService.DependencyGraph).
+//
+// GET /rest/agile/1.0/epic/{epicIdOrKey}/issue
+// GET /rest/agile/1.0/issue/{issueIdOrKey}
+func (e *EpicsService) DependencyGraph(ctx context.Context, idOrKey string, opts *DependencyGraphOptions) (*DependencyGraph, *Response, error) {
+
+	list := func(ctx context.Context, o *IssuesOptions) ([]*Issue, *Response, error) {
+		return e.ListIssues(ctx, idOrKey, o)
+	}
+
+	issues, resp, err := listAllIssues(ctx, &IssuesOptions{Fields: dependencyFields}, list)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	graph, graphResp, err := e.client.Issues.DependencyGraph(ctx, issues, opts)
+	if graphResp != nil {
+		resp = graphResp
+	}
+
+	return graph, resp, err
+}
@@ -0,0 +1,91 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// blocksLink returns an issue link JSON, outward when the issue blocks the linked one
+func blocksLink(name, key string, outward bool) string {
+	side := "inwardIssue"
+	if outward {
+		side = "outwardIssue"
+	}
+	return fmt.Sprintf(`{"type": {"name": "%s","inward": "is blocked by","outward": "blocks"},"%s": {"key": "%s"}}`, name, side, key)
+}
+
+func TestSprintsServiceDependencyGraph(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/37/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "summary,status,issuelinks", r.URL.Query().Get("fields"))
+		fmt.Fprintf(w, `{"isLast": true,"issues": [
+			{"key": "MCP-2","fields": {"issuelinks": [%s,%s]}},
+			{"key": "MCP-1","fields": {"issuelinks": [%s,%s]}}]}`,
+			blocksLink("Blocks", "MCP-1", false), blocksLink("Blocks", "MCP-9", true),
+			blocksLink("Blocks", "MCP-2", true), blocksLink("Relates", "MCP-7", true))
+	})
+
+	var fetched []string
+	mux.HandleFunc("/issue/MCP-9", func(w http.ResponseWriter, r *http.Request) {
+		fetched = append(fetched, "MCP-9")
+		fmt.Fprintf(w, `{"key": "MCP-9","fields": {"summary": "Deploy","issuelinks": [%s,%s]}}`,
+			blocksLink("Blocks", "MCP-2", false), blocksLink("Blocks", "MCP-10", true))
+	})
+
+	graph, _, err := client.Sprints.DependencyGraph(context.Background(), 37, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"MCP-2", "MCP-1"}, graph.Keys)
+	assert.Equal(t, []string{"MCP-2"}, graph.Nodes["MCP-1"].Blocks)
+	assert.Equal(t, []string{"MCP-1"}, graph.Nodes["MCP-2"].BlockedBy)
+	assert.Empty(t, fetched)
+
+	order, err := graph.TopologicalOrder()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"MCP-1", "MCP-2"}, order)
+
+	graph, _, err = client.Sprints.DependencyGraph(context.Background(), 37, &DependencyGraphOptions{Depth: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"MCP-2", "MCP-1", "MCP-9"}, graph.Keys)
+	assert.Equal(t, 1, graph.Nodes["MCP-9"].Depth)
+	assert.Equal(t, "Deploy", graph.Nodes["MCP-9"].Issue.Fields.Summary)
+	assert.Equal(t, []string{"MCP-2"}, graph.Nodes["MCP-9"].BlockedBy)
+	assert.Empty(t, graph.Nodes["MCP-9"].Blocks)
+	assert.Equal(t, []string{"MCP-9"}, fetched)
+
+	order, err = graph.TopologicalOrder()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"MCP-1", "MCP-2", "MCP-9"}, order)
+}
+
+func TestDependencyGraphCycles(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	issue := func(key string, links ...IssueLink) *Issue {
+		return &Issue{Key: key, Fields: &IssueField{Links: links}}
+	}
+	blocks := func(key string) IssueLink {
+		return IssueLink{Type: &IssueLinkType{Name: "Blocks"}, Outward: &Issue{Key: key}}
+	}
+
+	issues := []*Issue{
+		issue("MCP-1", blocks("MCP-2")),
+		issue("MCP-2", blocks("MCP-3")),
+		issue("MCP-3", blocks("MCP-1")),
+		issue("MCP-4", blocks("MCP-4")),
+		issue("MCP-5", blocks("MCP-1")),
+	}
+
+	graph, _, err := client.Issues.DependencyGraph(context.Background(), issues, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"MCP-1", "MCP-2", "MCP-3"}, {"MCP-4"}}, graph.Cycles())
+
+	_, err = graph.TopologicalOrder()
+	assert.EqualError(t, err, "jira: issues block each other: [MCP-1, MCP-2, MCP-3], [MCP-4]")
+}
@@ -0,0 +1,496 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Field operations of the update of an issue
+const (
+	FieldOperationSet    = "set"
+	FieldOperationAdd    = "add"
+	FieldOperationRemove = "remove"
+	FieldOperationEdit   = "edit"
+)
+
+// FieldOperation represents an operation on a field of an issue, e.g. adding a label.
+// It is encoded as {"add": "label"}.
+type FieldOperation struct {
+	Op    string
+	Value interface{}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (o *FieldOperation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{o.Op: o.Value})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (o *FieldOperation) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	for op, value := range m {
+		o.Op, o.Value = op, value
+	}
+	return nil
+}
+
+// IssueRequest contains the fields of an issue to create or edit. Fields are set to the
+// given values and the operations of Update are applied to their field, e.g.
+//
+//	&IssueRequest{
+//		Fields: map[string]interface{}{"summary": "New summary"},
+//		Update: map[string][]*FieldOperation{"labels": {{Op: FieldOperationAdd, Value: "triage"}}},
+//	}
+type IssueRequest struct {
+	Fields map[string]interface{}       `json:"fields,omitempty"`
+	Update map[string][]*FieldOperation `json:"update,omitempty"`
+}
+
+// EditMeta contains the fields of an issue that can be edited
+type EditMeta struct {
+	Fields map[string]*FieldMeta `json:"fields,omitempty"`
+}
+
+// ValidationError is returned when an issue request does not match the create or edit
+// metadata of the issue. Errors contains a message per field id.
+type ValidationError struct {
+	Errors map[string]string
+}
+
+func (e *ValidationError) Error() string {
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	messages := make([]string, len(fields))
+	for i, field := range fields {
+		messages[i] = field + ": " + e.Errors[field]
+	}
+
+	return "jira: invalid issue, " + strings.Join(messages, "; ")
+}
+
+func (e *ValidationError) add(field, format string, args ...interface{}) {
+	if e.Errors == nil {
+		e.Errors = map[string]string{}
+	}
+	e.Errors[field] = fmt.Sprintf(format, args...)
+}
+
+func (e *ValidationError) err() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
+
+// ref contains the properties used to reference an entity in a field, e.g. a project
+// is referenced by id or key and a component by id or name
+type ref struct {
+	ID    string
+	Key   string
+	Name  string
+	Value string
+}
+
+// toRef returns the reference of a field value, strings and numbers are references
+// by themselves
+func toRef(value interface{}) (*ref, bool) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		var v interface{}
+		if json.Unmarshal(b, &v) != nil || v == nil {
+			return nil, false
+		}
+		s := fmt.Sprint(v)
+		return &ref{ID: s, Key: s, Name: s, Value: s}, true
+	}
+
+	get := func(k string) string {
+		if v, ok := m[k]; ok && v != nil {
+			return fmt.Sprint(v)
+		}
+		return ""
+	}
+	return &ref{ID: get("id"), Key: get("key"), Name: get("name"), Value: get("value")}, true
+}
+
+// matches reports whether the reference designates the allowed value
+func (r *ref) matches(allowed *ref) bool {
+	eq := func(a, b string) bool { return a != "" && a == b }
+	return eq(r.ID, allowed.ID) || eq(r.Key, allowed.Key) || eq(r.Name, allowed.Name) || eq(r.Value, allowed.Value)
+}
+
+// values returns the elements of a field value, a single value is returned as is
+func values(value interface{}) []interface{} {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return []interface{}{value}
+	}
+
+	var list []json.RawMessage
+	if json.Unmarshal(b, &list) != nil {
+		return []interface{}{value}
+	}
+
+	items := make([]interface{}, len(list))
+	for i, item := range list {
+		items[i] = item
+	}
+	return items
+}
+
+// empty reports whether the value of a field is empty
+func empty(value interface{}) bool {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	s := string(b)
+	return s == "null" || s == `""` || s == "[]" || s == "{}"
+}
+
+// validateValue checks that the elements of the value are allowed values of the field
+func validateValue(verr *ValidationError, id string, meta *FieldMeta, value interface{}) {
+	if len(meta.AllowedValues) == 0 || empty(value) {
+		return
+	}
+
+	allowed := make([]*ref, 0, len(meta.AllowedValues))
+	for _, raw := range meta.AllowedValues {
+		if r, ok := toRef(raw); ok {
+			allowed = append(allowed, r)
+		}
+	}
+
+	for _, v := range values(value) {
+		r, ok := toRef(v)
+		if !ok {
+			verr.add(id, "invalid value")
+			return
+		}
+
+		found := false
+		for _, a := range allowed {
+			if r.matches(a) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			b, _ := json.Marshal(v)
+			verr.add(id, "%s is not an allowed value of %s", b, fieldName(id, meta))
+			return
+		}
+	}
+}
+
+func fieldName(id string, meta *FieldMeta) string {
+	if meta != nil && meta.Name != "" {
+		return meta.Name
+	}
+	return id
+}
+
+// validateCreate checks the request against the create metadata of its issue type
+func validateCreate(request *IssueRequest, issueType *CreateMetaIssueType) error {
+	verr := &ValidationError{}
+
+	for id, meta := range issueType.Fields {
+		_, inFields := request.Fields[id]
+		_, inUpdate := request.Update[id]
+		if meta.Required && !meta.HasDefaultValue && (!inFields || empty(request.Fields[id])) && !inUpdate {
+			verr.add(id, "%s is required", fieldName(id, meta))
+		}
+	}
+
+	for id, value := range request.Fields {
+		if id == "project" || id == "issuetype" {
+			continue
+		}
+		meta, ok := issueType.Fields[id]
+		if !ok {
+			verr.add(id, "field is not on the create screen of issue type %s", issueType.Name)
+			continue
+		}
+		validateValue(verr, id, meta, value)
+	}
+
+	validateOperations(verr, request.Update, issueType.Fields)
+
+	return verr.err()
+}
+
+// validateEdit checks the request against the edit metadata of the issue
+func validateEdit(request *IssueRequest, meta *EditMeta) error {
+	verr := &ValidationError{}
+
+	for id, value := range request.Fields {
+		field, ok := meta.Fields[id]
+		if !ok {
+			verr.add(id, "field is not on the edit screen of the issue")
+			continue
+		}
+		if len(field.Operations) > 0 && !contains(field.Operations, FieldOperationSet) {
+			verr.add(id, "%s can not be set", fieldName(id, field))
+			continue
+		}
+		if field.Required && empty(value) {
+			verr.add(id, "%s is required", fieldName(id, field))
+			continue
+		}
+		validateValue(verr, id, field, value)
+	}
+
+	validateOperations(verr, request.Update, meta.Fields)
+
+	return verr.err()
+}
+
+// validateOperations checks that the operations are supported by their field and that
+// the values added or set are allowed
+func validateOperations(verr *ValidationError, update map[string][]*FieldOperation, fields map[string]*FieldMeta) {
+	for id, ops := range update {
+		field, ok := fields[id]
+		if !ok {
+			verr.add(id, "field is not on the screen of the issue")
+			continue
+		}
+		for _, op := range ops {
+			if len(field.Operations) > 0 && !contains(field.Operations, op.Op) {
+				verr.add(id, "%s does not support the %s operation", fieldName(id, field), op.Op)
+				break
+			}
+			if op.Op != FieldOperationRemove {
+				validateValue(verr, id, field, op.Value)
+			}
+		}
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// GetEditMeta returns the fields of the issue that can be edited, with their allowed
+// values and operations.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/editmeta
+func (i *IssuesService) GetEditMeta(ctx context.Context, idOrKey string) (*EditMeta, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sissue/%s/editmeta", apiPath, idOrKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var meta = &EditMeta{}
+	resp, err := i.client.Do(ctx, req, meta)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return meta, resp, nil
+}
+
+// Create creates an issue. The project and the issue type are defined by the project and
+// issuetype fields. Before the issue is created, the request is validated against the
+// create metadata of the issue type, a *ValidationError is returned when a required
+// field is missing, a field is not on the create screen or a value is not allowed.
+// Only the id, the key and the self link of the issue are returned.
+//
+// GET /rest/api/2/issue/createmeta
+// POST /rest/api/2/issue
+func (i *IssuesService) Create(ctx context.Context, request *IssueRequest) (*Issue, *Response, error) {
+
+	project, ok := toRef(request.Fields["project"])
+	if !ok || (project.ID == "" && project.Key == "") {
+		return nil, nil, &ValidationError{Errors: map[string]string{"project": "project is required"}}
+	}
+	issueType, ok := toRef(request.Fields["issuetype"])
+	if !ok || (issueType.ID == "" && issueType.Name == "") {
+		return nil, nil, &ValidationError{Errors: map[string]string{"issuetype": "issue type is required"}}
+	}
+
+	opts := &CreateMetaOptions{Expand: "projects.issuetypes.fields"}
+	if project.Key != "" {
+		opts.ProjectKeys = project.Key
+	} else {
+		opts.ProjectIDs = project.ID
+	}
+	if issueType.ID != "" {
+		opts.IssueTypeIDs = issueType.ID
+	} else {
+		opts.IssueTypeNames = issueType.Name
+	}
+
+	meta, resp, err := i.GetCreateMeta(ctx, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var typeMeta *CreateMetaIssueType
+	for _, p := range meta.Projects {
+		if !project.matches(&ref{ID: p.ID, Key: p.Key}) {
+			continue
+		}
+		for _, t := range p.IssueTypes {
+			if issueType.matches(&ref{ID: t.ID, Name: t.Name}) {
+				typeMeta = t
+			}
+		}
+	}
+	if typeMeta == nil {
+		return nil, resp, &ValidationError{Errors: map[string]string{
+			"issuetype": "issue type can not be created in the project",
+		}}
+	}
+
+	if err := validateCreate(request, typeMeta); err != nil {
+		return nil, resp, err
+	}
+
+	req, err := i.client.NewRequest("POST", apiPath+"issue", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var issue = &Issue{}
+	resp, err = i.client.Do(ctx, req, issue)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return issue, resp, nil
+}
+
+// Edit edits an issue, setting the fields and applying the operations of the request.
+// Before the issue is edited, the request is validated against the edit metadata of the
+// issue, a *ValidationError is returned when a field can not be edited, a required field
+// is cleared, an operation is not supported or a value is not allowed.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/editmeta
+// PUT /rest/api/2/issue/{issueIdOrKey}
+func (i *IssuesService) Edit(ctx context.Context, idOrKey string, request *IssueRequest) (bool, *Response, error) {
+
+	meta, resp, err := i.GetEditMeta(ctx, idOrKey)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if err := validateEdit(request, meta); err != nil {
+		return false, resp, err
+	}
+
+	req, err := i.client.NewRequest("PUT", fmt.Sprintf("%sissue/%s", apiPath, idOrKey), request)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err = i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// Delete deletes an issue. An issue with sub-tasks can only be deleted when deleteSubtasks
+// is true, the sub-tasks are deleted with it.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}
+func (i *IssuesService) Delete(ctx context.Context, idOrKey string, deleteSubtasks bool) (bool, *Response, error) {
+
+	u := fmt.Sprintf("%sissue/%s", apiPath, idOrKey)
+	if deleteSubtasks {
+		u += "?deleteSubtasks=true"
+	}
+
+	req, err := i.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// userID returns the query parameter and the value that identify the user, its account id
+// (Jira Cloud) or its name (Jira Server)
+func userID(user *IssueUser) (string, string, error) {
+	if user != nil && user.AccountID != "" {
+		return "accountId", user.AccountID, nil
+	}
+	if user != nil && user.Name != "" {
+		return "username", user.Name, nil
+	}
+	return "", "", fmt.Errorf("jira: a user is identified by its account id or its name")
+}
+
+// Assign assigns an issue to a user, defined by its AccountID (Jira Cloud) or its Name
+// (Jira Server), the AccountID is used when both are set. The issue is unassigned when
+// the user is nil and assigned to the default assignee of the project when the name
+// is "-1". An error is returned, without any request, when the user has neither.
+//
+// PUT /rest/api/2/issue/{issueIdOrKey}/assignee
+func (i *IssuesService) Assign(ctx context.Context, idOrKey string, user *IssueUser) (bool, *Response, error) {
+
+	body := map[string]interface{}{"name": nil}
+	if user != nil {
+		param, id, err := userID(user)
+		if err != nil {
+			return false, nil, err
+		}
+		// the assignee body uses name where query parameters use username
+		if param == "username" {
+			param = "name"
+		}
+		body = map[string]interface{}{param: id}
+	}
+
+	req, err := i.client.NewRequest("PUT", fmt.Sprintf("%sissue/%s/assignee", apiPath, idOrKey), body)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
@@ -0,0 +1,188 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const taskCreateMeta = `{"projects": [{"id": "10000","key": "MCP","issuetypes": [{"id": "3","name": "Task","fields": {
+	"project": {"required": true,"name": "Project"},
+	"issuetype": {"required": true,"name": "Issue Type"},
+	"summary": {"required": true,"name": "Summary","operations": ["set"]},
+	"priority": {"required": true,"hasDefaultValue": true,"name": "Priority","operations": ["set"],
+		"allowedValues": [{"id": "1","name": "High"},{"id": "2","name": "Low"}]},
+	"components": {"required": false,"name": "Components","operations": ["add","set","remove"],
+		"allowedValues": [{"id": "10000","name": "Billing"},{"id": "10001","name": "Search"}]},
+	"labels": {"required": false,"name": "Labels","operations": ["add","set","remove"]}}}]}]}`
+
+func TestIssuesServiceCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "MCP", r.URL.Query().Get("projectKeys"))
+		assert.Equal(t, "Task", r.URL.Query().Get("issuetypeNames"))
+		fmt.Fprint(w, taskCreateMeta)
+	})
+
+	mux.HandleFunc("/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"labels": []interface{}{map[string]interface{}{"add": "triage"}}}, body["update"])
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "10050","key": "MCP-24","self": "http://www.example.com/jira/rest/api/2/issue/10050"}`)
+	})
+
+	request := &IssueRequest{
+		Fields: map[string]interface{}{
+			"project":    map[string]string{"key": "MCP"},
+			"issuetype":  map[string]string{"name": "Task"},
+			"summary":    "Broken invoice",
+			"components": []map[string]string{{"name": "Billing"}},
+		},
+		Update: map[string][]*FieldOperation{
+			"labels": {{Op: FieldOperationAdd, Value: "triage"}},
+		},
+	}
+
+	issue, _, err := client.Issues.Create(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-24", issue.Key)
+}
+
+func TestIssuesServiceCreateValidation(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "10000", r.URL.Query().Get("projectIds"))
+		fmt.Fprint(w, taskCreateMeta)
+	})
+
+	mux.HandleFunc("/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the issue must not be created")
+	})
+
+	request := &IssueRequest{
+		Fields: map[string]interface{}{
+			"project":     map[string]string{"id": "10000"},
+			"issuetype":   map[string]string{"id": "3"},
+			"components":  []map[string]string{{"id": "10000"}, {"id": "99"}},
+			"environment": "prod",
+		},
+		Update: map[string][]*FieldOperation{
+			"priority": {{Op: FieldOperationAdd, Value: map[string]string{"name": "High"}}},
+		},
+	}
+
+	_, _, err := client.Issues.Create(context.Background(), request)
+	verr, ok := err.(*ValidationError)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]string{
+		"summary":     "Summary is required",
+		"components":  `{"id":"99"} is not an allowed value of Components`,
+		"environment": "field is not on the create screen of issue type Task",
+		"priority":    "Priority does not support the add operation",
+	}, verr.Errors)
+	assert.Contains(t, err.Error(), "jira: invalid issue, components: ")
+
+	_, _, err = client.Issues.Create(context.Background(), &IssueRequest{Fields: map[string]interface{}{"summary": "No project"}})
+	_, ok = err.(*ValidationError)
+	assert.True(t, ok)
+
+	request.Fields["issuetype"] = map[string]string{"id": "4"}
+	_, _, err = client.Issues.Create(context.Background(), request)
+	assert.Equal(t, "jira: invalid issue, issuetype: issue type can not be created in the project", err.Error())
+}
+
+func TestIssuesServiceEdit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-24/editmeta", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"fields": {
+			"summary": {"required": true,"name": "Summary","operations": ["set"]},
+			"labels": {"required": false,"name": "Labels","operations": ["add","set","remove"]},
+			"priority": {"required": false,"name": "Priority","operations": ["set"],"allowedValues": [{"id": "1","name": "High"}]}}}`)
+	})
+
+	var edits []map[string]interface{}
+	mux.HandleFunc("/api/2/issue/MCP-24", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		edits = append(edits, body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	request := &IssueRequest{
+		Fields: map[string]interface{}{"summary": "Renamed", "priority": map[string]string{"name": "High"}},
+		Update: map[string][]*FieldOperation{
+			"labels": {{Op: FieldOperationAdd, Value: "triage"}, {Op: FieldOperationRemove, Value: "new"}},
+		},
+	}
+
+	edited, _, err := client.Issues.Edit(context.Background(), "MCP-24", request)
+	assert.Nil(t, err)
+	assert.True(t, edited)
+	assert.Equal(t, []interface{}{map[string]interface{}{"add": "triage"}, map[string]interface{}{"remove": "new"}}, edits[0]["update"].(map[string]interface{})["labels"])
+
+	invalid := &IssueRequest{
+		Fields: map[string]interface{}{"summary": "", "duedate": "2019-01-01", "priority": map[string]string{"name": "Low"}},
+		Update: map[string][]*FieldOperation{"summary": {{Op: FieldOperationAdd, Value: "x"}}},
+	}
+
+	_, _, err = client.Issues.Edit(context.Background(), "MCP-24", invalid)
+	verr, ok := err.(*ValidationError)
+	assert.True(t, ok)
+	assert.Len(t, verr.Errors, 3)
+	assert.Equal(t, "Summary does not support the add operation", verr.Errors["summary"])
+	assert.Equal(t, "field is not on the edit screen of the issue", verr.Errors["duedate"])
+	assert.Len(t, edits, 1)
+}
+
+func TestIssuesServiceDeleteAndAssign(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-24", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "true", r.URL.Query().Get("deleteSubtasks"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var assignees []map[string]interface{}
+	mux.HandleFunc("/api/2/issue/MCP-25/assignee", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assignees = append(assignees, body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	deleted, _, err := client.Issues.Delete(context.Background(), "MCP-24", true)
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+
+	_, _, err = client.Issues.Assign(context.Background(), "MCP-25", &IssueUser{AccountID: "5b10a2844c20165700ede21g"})
+	assert.Nil(t, err)
+	_, _, err = client.Issues.Assign(context.Background(), "MCP-25", &IssueUser{Name: "fred"})
+	assert.Nil(t, err)
+	_, _, err = client.Issues.Assign(context.Background(), "MCP-25", nil)
+	assert.Nil(t, err)
+	_, _, err = client.Issues.Assign(context.Background(), "MCP-25", &IssueUser{DisplayName: "Fred"})
+	assert.EqualError(t, err, "jira: a user is identified by its account id or its name")
+
+	assert.Equal(t, []map[string]interface{}{
+		{"accountId": "5b10a2844c20165700ede21g"},
+		{"name": "fred"},
+		{"name": nil},
+	}, assignees)
+}
@@ -0,0 +1,128 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// BlocksLinkType is the name of the link type of the blocks/is blocked by links
+const BlocksLinkType = "Blocks"
+
+// LinkRequest contains the issues to link, by id or key, and the name of the link type,
+// e.g. Blocks. The issues are on the inward and outward sides of the link as defined by
+// the link type.
+type LinkRequest struct {
+	Type         string
+	InwardIssue  string
+	OutwardIssue string
+	//A comment added to the outward issue.
+	Comment string
+}
+
+// LinkTypesWrap represents the data returned by the API
+type LinkTypesWrap struct {
+	IssueLinkTypes []*IssueLinkType `json:"issueLinkTypes,omitempty"`
+}
+
+// issueRef returns a reference to the issue for its id or key
+func issueRef(idOrKey string) map[string]string {
+	for _, c := range idOrKey {
+		if c < '0' || c > '9' {
+			return map[string]string{"key": idOrKey}
+		}
+	}
+	return map[string]string{"id": idOrKey}
+}
+
+// CreateLink links two issues. Jira does not return the created link, it is listed in
+// the issue links of both issues.
+//
+// POST /rest/api/2/issueLink
+func (i *IssuesService) CreateLink(ctx context.Context, link *LinkRequest) (bool, *Response, error) {
+
+	body := map[string]interface{}{
+		"type":         map[string]string{"name": link.Type},
+		"inwardIssue":  issueRef(link.InwardIssue),
+		"outwardIssue": issueRef(link.OutwardIssue),
+	}
+	if link.Comment != "" {
+		body["comment"] = map[string]string{"body": link.Comment}
+	}
+
+	req, err := i.client.NewRequest("POST", apiPath+"issueLink", body)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusCreated {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// GetLink returns an issue link with the issues on both of its sides.
+//
+// GET /rest/api/2/issueLink/{linkId}
+func (i *IssuesService) GetLink(ctx context.Context, linkID string) (*IssueLink, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sissueLink/%s", apiPath, linkID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var link = &IssueLink{}
+	resp, err := i.client.Do(ctx, req, link)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return link, resp, nil
+}
+
+// DeleteLink deletes an issue link.
+//
+// DELETE /rest/api/2/issueLink/{linkId}
+func (i *IssuesService) DeleteLink(ctx context.Context, linkID string) (bool, *Response, error) {
+
+	req, err := i.client.NewRequest("DELETE", fmt.Sprintf("%sissueLink/%s", apiPath, linkID), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// ListLinkTypes returns all issue link types.
+//
+// GET /rest/api/2/issueLinkType
+func (i *IssuesService) ListLinkTypes(ctx context.Context) ([]*IssueLinkType, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", apiPath+"issueLinkType", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &LinkTypesWrap{}
+	resp, err := i.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wrap.IssueLinkTypes, resp, nil
+}
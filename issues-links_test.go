@@ -0,0 +1,76 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuesServiceCreateLink(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issueLink", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"name": "Blocks"}, body["type"])
+		assert.Equal(t, map[string]interface{}{"key": "MCP-2"}, body["inwardIssue"])
+		assert.Equal(t, map[string]interface{}{"id": "10001"}, body["outwardIssue"])
+		assert.Equal(t, map[string]interface{}{"body": "Linked related issue!"}, body["comment"])
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	link := &LinkRequest{Type: BlocksLinkType, InwardIssue: "MCP-2", OutwardIssue: "10001", Comment: "Linked related issue!"}
+	created, _, err := client.Issues.CreateLink(context.Background(), link)
+	assert.Nil(t, err)
+	assert.True(t, created)
+}
+
+func TestIssuesServiceGetDeleteLink(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issueLink/10000", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id": "10000","type": {"id": "10000","name": "Blocks","inward": "is blocked by","outward": "blocks"},
+				"inwardIssue": {"id": "10001","key": "MCP-2"},"outwardIssue": {"id": "10004","key": "MCP-5"}}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	link, _, err := client.Issues.GetLink(context.Background(), "10000")
+	assert.Nil(t, err)
+	assert.Equal(t, "blocks", link.Type.Outward)
+	assert.Equal(t, "MCP-2", link.Inward.Key)
+	assert.Equal(t, "MCP-5", link.Outward.Key)
+
+	deleted, _, err := client.Issues.DeleteLink(context.Background(), "10000")
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
+
+func TestIssuesServiceListLinkTypes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issueLinkType", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"issueLinkTypes": [{"id": "10000","name": "Blocks","inward": "is blocked by","outward": "blocks"},
+			{"id": "10001","name": "Relates","inward": "relates to","outward": "relates to"}]}`)
+	})
+
+	types, _, err := client.Issues.ListLinkTypes(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, types, 2)
+	assert.Equal(t, "is blocked by", types[0].Inward)
+	assert.Equal(t, "Relates", types[1].Name)
+}
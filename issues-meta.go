@@ -0,0 +1,103 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Custom field types of Jira Software
+const (
+	EpicNameFieldType = "com.pyxis.greenhopper.jira:gh-epic-label"
+	EpicLinkFieldType = "com.pyxis.greenhopper.jira:gh-epic-link"
+)
+
+// CreateMeta represents the projects and issue types where the user can create issues,
+// with the fields of each issue type
+type CreateMeta struct {
+	Projects []*CreateMetaProject `json:"projects,omitempty"`
+}
+
+// CreateMetaProject represents a project of the create metadata
+type CreateMetaProject struct {
+	ID         string                 `json:"id,omitempty"`
+	Key        string                 `json:"key,omitempty"`
+	Name       string                 `json:"name,omitempty"`
+	SelfLink   string                 `json:"self,omitempty"`
+	IssueTypes []*CreateMetaIssueType `json:"issuetypes,omitempty"`
+}
+
+// CreateMetaIssueType represents an issue type of the create metadata, the fields
+// are indexed by their id and only returned when the fields are expanded
+type CreateMetaIssueType struct {
+	IssueType
+	Fields map[string]*FieldMeta `json:"fields,omitempty"`
+}
+
+// FieldMeta describes a field of the create or edit screen of an issue
+type FieldMeta struct {
+	Key             string            `json:"key,omitempty"`
+	Name            string            `json:"name,omitempty"`
+	Required        bool              `json:"required,omitempty"`
+	Schema          *FieldSchema      `json:"schema,omitempty"`
+	HasDefaultValue bool              `json:"hasDefaultValue,omitempty"`
+	Operations      []string          `json:"operations,omitempty"`
+	AllowedValues   []json.RawMessage `json:"allowedValues,omitempty"`
+	AutoCompleteURL string            `json:"autoCompleteUrl,omitempty"`
+}
+
+// FieldSchema describes the type of a field
+type FieldSchema struct {
+	Type     string `json:"type,omitempty"`
+	Items    string `json:"items,omitempty"`
+	System   string `json:"system,omitempty"`
+	Custom   string `json:"custom,omitempty"`
+	CustomID int    `json:"customId,omitempty"`
+}
+
+// CreateMetaOptions contains all options to get the create metadata
+type CreateMetaOptions struct {
+	//List of project ids. This parameter can be specified multiple times, and/or be a comma-separated list.
+	ProjectIDs string `query:"projectIds"`
+	//List of project keys. This parameter can be specified multiple times, and/or be a comma-separated list.
+	ProjectKeys string `query:"projectKeys"`
+	//List of issue type ids. This parameter can be specified multiple times, and/or be a comma-separated list.
+	IssueTypeIDs string `query:"issuetypeIds"`
+	//List of issue type names. This parameter can be specified multiple times, and/or be a comma-separated list.
+	IssueTypeNames string `query:"issuetypeNames"`
+	//Use projects.issuetypes.fields to return the fields of each issue type.
+	Expand string `query:"expand"`
+}
+
+// FieldByType returns the id and metadata of the first field of the issue type whose
+// custom type is the given one, e.g. EpicNameFieldType
+func (t *CreateMetaIssueType) FieldByType(custom string) (string, *FieldMeta) {
+	for id, field := range t.Fields {
+		if field.Schema != nil && field.Schema.Custom == custom {
+			return id, field
+		}
+	}
+	return "", nil
+}
+
+// GetCreateMeta returns the metadata to create issues, the projects and issue types where
+// the user can create issues and, when projects.issuetypes.fields is expanded, the fields
+// of each issue type.
+//
+// GET /rest/api/2/issue/createmeta
+func (i *IssuesService) GetCreateMeta(ctx context.Context, opts *CreateMetaOptions) (*CreateMeta, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("GET", apiPath+"issue/createmeta"+q, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var meta = &CreateMeta{}
+	resp, err := i.client.Do(ctx, req, meta)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return meta, resp, nil
+}
@@ -0,0 +1,44 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuesServiceGetCreateMeta(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "MCP", r.URL.Query().Get("projectKeys"))
+		assert.Equal(t, "projects.issuetypes.fields", r.URL.Query().Get("expand"))
+		fmt.Fprint(w, `{"projects": [{"id": "10000","key": "MCP","name": "My Project","issuetypes": [
+			{"id": "10001","name": "Epic","subtask": false,"fields": {
+				"summary": {"required": true,"name": "Summary","schema": {"type": "string","system": "summary"},"operations": ["set"]},
+				"customfield_10011": {"required": true,"name": "Epic Name","schema": {"type": "string","custom": "com.pyxis.greenhopper.jira:gh-epic-label","customId": 10011}}
+			}}]}]}`)
+	})
+
+	opts := &CreateMetaOptions{ProjectKeys: "MCP", Expand: "projects.issuetypes.fields"}
+	meta, _, err := client.Issues.GetCreateMeta(context.Background(), opts)
+	assert.Nil(t, err)
+	assert.Len(t, meta.Projects, 1)
+
+	issueType := meta.Projects[0].IssueTypes[0]
+	assert.Equal(t, "Epic", issueType.Name)
+	assert.True(t, issueType.Fields["summary"].Required)
+	assert.Equal(t, []string{"set"}, issueType.Fields["summary"].Operations)
+
+	id, field := issueType.FieldByType(EpicNameFieldType)
+	assert.Equal(t, "customfield_10011", id)
+	assert.Equal(t, 10011, field.Schema.CustomID)
+
+	id, field = issueType.FieldByType(EpicLinkFieldType)
+	assert.Equal(t, "", id)
+	assert.Nil(t, field)
+}
@@ -0,0 +1,221 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// RemoteLink represents a link from an issue to an object of another system, e.g. a CI
+// build or an incident
+type RemoteLink struct {
+	ID       int    `json:"id,omitempty"`
+	SelfLink string `json:"self,omitempty"`
+	//Identifies the remote object, a remote link is updated instead of created when an
+	//issue already has a remote link with the same global id.
+	GlobalID    string                 `json:"globalId,omitempty"`
+	Application *RemoteLinkApplication `json:"application,omitempty"`
+	//The relationship of the issue with the remote object, e.g. causes.
+	Relationship string            `json:"relationship,omitempty"`
+	Object       *RemoteLinkObject `json:"object,omitempty"`
+}
+
+// RemoteLinkApplication represents the application of a remote object
+type RemoteLinkApplication struct {
+	//The type of the application in reverse domain name notation, e.g. com.acme.ci.
+	Type string `json:"type,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// RemoteLinkObject represents the remote object of a remote link
+type RemoteLinkObject struct {
+	URL     string            `json:"url,omitempty"`
+	Title   string            `json:"title,omitempty"`
+	Summary string            `json:"summary,omitempty"`
+	Icon    *RemoteLinkIcon   `json:"icon,omitempty"`
+	Status  *RemoteLinkStatus `json:"status,omitempty"`
+}
+
+// RemoteLinkIcon represents an icon of a remote object
+type RemoteLinkIcon struct {
+	URL16x16 string `json:"url16x16,omitempty"`
+	Title    string `json:"title,omitempty"`
+	//The URL the icon links to.
+	Link string `json:"link,omitempty"`
+}
+
+// RemoteLinkStatus represents the status of a remote object, a resolved object is
+// displayed struck through
+type RemoteLinkStatus struct {
+	Resolved bool            `json:"resolved"`
+	Icon     *RemoteLinkIcon `json:"icon,omitempty"`
+}
+
+// ListRemoteLinks returns the remote links of the issue.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/remotelink
+func (i *IssuesService) ListRemoteLinks(ctx context.Context, idOrKey string) ([]*RemoteLink, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sissue/%s/remotelink", apiPath, idOrKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var links []*RemoteLink
+	resp, err := i.client.Do(ctx, req, &links)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return links, resp, nil
+}
+
+// GetRemoteLink returns a remote link of the issue.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/remotelink/{linkId}
+func (i *IssuesService) GetRemoteLink(ctx context.Context, idOrKey string, linkID int) (*RemoteLink, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sissue/%s/remotelink/%d", apiPath, idOrKey, linkID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var link = &RemoteLink{}
+	resp, err := i.client.Do(ctx, req, link)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return link, resp, nil
+}
+
+// GetRemoteLinkByGlobalID returns the remote link of the issue with the given global id.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/remotelink?globalId={globalId}
+func (i *IssuesService) GetRemoteLinkByGlobalID(ctx context.Context, idOrKey string, globalID string) (*RemoteLink, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sissue/%s/remotelink?globalId=%s", apiPath, idOrKey, url.QueryEscape(globalID)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var raw json.RawMessage
+	resp, err := i.client.Do(ctx, req, &raw)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// depending on the Jira version, the link is returned alone or in a list
+	var links []*RemoteLink
+	if err := json.Unmarshal(raw, &links); err != nil {
+		var link = &RemoteLink{}
+		if err := json.Unmarshal(raw, link); err != nil {
+			return nil, resp, err
+		}
+		return link, resp, nil
+	}
+
+	for _, link := range links {
+		if link.GlobalID == globalID {
+			return link, resp, nil
+		}
+	}
+
+	return nil, resp, fmt.Errorf("jira: issue %s has no remote link %s", idOrKey, globalID)
+}
+
+// writableRemoteLink returns a copy of the remote link without the read-only fields
+func writableRemoteLink(link *RemoteLink) *RemoteLink {
+	w := *link
+	w.ID = 0
+	w.SelfLink = ""
+	return &w
+}
+
+// UpsertRemoteLink creates a remote link of the issue, or updates the remote link of the
+// issue with the same global id, so that running it again does not create a duplicate.
+// The global id is required. The returned link has its id and self link set, the status
+// code of the response is 201 when the link was created and 200 when it was updated.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/remotelink
+func (i *IssuesService) UpsertRemoteLink(ctx context.Context, idOrKey string, link *RemoteLink) (*RemoteLink, *Response, error) {
+
+	if link.GlobalID == "" {
+		return nil, nil, fmt.Errorf("jira: a global id is required to create or update a remote link")
+	}
+
+	w := writableRemoteLink(link)
+
+	req, err := i.client.NewRequest("POST", fmt.Sprintf("%sissue/%s/remotelink", apiPath, idOrKey), w)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created = &RemoteLink{}
+	resp, err := i.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	w.ID = created.ID
+	w.SelfLink = created.SelfLink
+
+	return w, resp, nil
+}
+
+// UpdateRemoteLink replaces a remote link of the issue.
+//
+// PUT /rest/api/2/issue/{issueIdOrKey}/remotelink/{linkId}
+func (i *IssuesService) UpdateRemoteLink(ctx context.Context, idOrKey string, linkID int, link *RemoteLink) (bool, *Response, error) {
+
+	req, err := i.client.NewRequest("PUT", fmt.Sprintf("%sissue/%s/remotelink/%d", apiPath, idOrKey, linkID), writableRemoteLink(link))
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// DeleteRemoteLink deletes a remote link of the issue.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}/remotelink/{linkId}
+func (i *IssuesService) DeleteRemoteLink(ctx context.Context, idOrKey string, linkID int) (bool, *Response, error) {
+	return i.deleteRemoteLink(ctx, fmt.Sprintf("%sissue/%s/remotelink/%d", apiPath, idOrKey, linkID))
+}
+
+// DeleteRemoteLinkByGlobalID deletes the remote link of the issue with the given global id.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}/remotelink?globalId={globalId}
+func (i *IssuesService) DeleteRemoteLinkByGlobalID(ctx context.Context, idOrKey string, globalID string) (bool, *Response, error) {
+	return i.deleteRemoteLink(ctx, fmt.Sprintf("%sissue/%s/remotelink?globalId=%s", apiPath, idOrKey, url.QueryEscape(globalID)))
+}
+
+func (i *IssuesService) deleteRemoteLink(ctx context.Context, urlStr string) (bool, *Response, error) {
+
+	req, err := i.client.NewRequest("DELETE", urlStr, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
@@ -0,0 +1,131 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const remoteLinkJSON = `{"id": 10000,"self": "http://www.example.com/jira/rest/api/issue/MKY-1/remotelink/10000",
+	"globalId": "system=http://www.mycompany.com/support&id=1",
+	"application": {"type": "com.acme.tracker","name": "My Acme Tracker"},"relationship": "causes",
+	"object": {"url": "http://www.mycompany.com/support?id=1","title": "TSTSUP-111","summary": "Crazy customer support issue",
+		"icon": {"url16x16": "http://www.mycompany.com/support/ticket.png","title": "Support Ticket"},
+		"status": {"resolved": true,"icon": {"url16x16": "http://www.mycompany.com/support/resolved.png","title": "Case Closed"}}}}`
+
+func TestIssuesServiceListGetRemoteLinks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MKY-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		if r.URL.Query().Get("globalId") != "" {
+			assert.Equal(t, "system=http://www.mycompany.com/support&id=1", r.URL.Query().Get("globalId"))
+			fmt.Fprint(w, remoteLinkJSON)
+			return
+		}
+		fmt.Fprintf(w, `[%s]`, remoteLinkJSON)
+	})
+
+	mux.HandleFunc("/api/2/issue/MKY-1/remotelink/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, remoteLinkJSON)
+	})
+
+	links, _, err := client.Issues.ListRemoteLinks(context.Background(), "MKY-1")
+	assert.Nil(t, err)
+	assert.Len(t, links, 1)
+	assert.Equal(t, "com.acme.tracker", links[0].Application.Type)
+	assert.True(t, links[0].Object.Status.Resolved)
+	assert.Equal(t, "Case Closed", links[0].Object.Status.Icon.Title)
+
+	link, _, err := client.Issues.GetRemoteLink(context.Background(), "MKY-1", 10000)
+	assert.Nil(t, err)
+	assert.Equal(t, "causes", link.Relationship)
+
+	link, _, err = client.Issues.GetRemoteLinkByGlobalID(context.Background(), "MKY-1", "system=http://www.mycompany.com/support&id=1")
+	assert.Nil(t, err)
+	assert.Equal(t, 10000, link.ID)
+}
+
+func TestIssuesServiceUpsertRemoteLink(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	stored := map[string]int{}
+	mux.HandleFunc("/api/2/issue/MKY-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.NotContains(t, body, "id")
+		assert.NotContains(t, body, "self")
+
+		globalID := body["globalId"].(string)
+		id, ok := stored[globalID]
+		if !ok {
+			id = 10000 + len(stored)
+			stored[globalID] = id
+			w.WriteHeader(http.StatusCreated)
+		}
+		fmt.Fprintf(w, `{"id": %d,"self": "http://www.example.com/jira/rest/api/issue/MKY-1/remotelink/%d"}`, id, id)
+	})
+
+	link := &RemoteLink{
+		GlobalID: "ci=build&id=42",
+		Object:   &RemoteLinkObject{URL: "https://ci.example.com/builds/42", Title: "Build #42"},
+	}
+
+	created, resp, err := client.Issues.UpsertRemoteLink(context.Background(), "MKY-1", link)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, 10000, created.ID)
+	assert.Equal(t, "Build #42", created.Object.Title)
+
+	created.Object.Title = "Build #42 (passed)"
+	updated, resp, err := client.Issues.UpsertRemoteLink(context.Background(), "MKY-1", created)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 10000, updated.ID)
+	assert.Len(t, stored, 1)
+
+	_, _, err = client.Issues.UpsertRemoteLink(context.Background(), "MKY-1", &RemoteLink{Object: link.Object})
+	assert.EqualError(t, err, "jira: a global id is required to create or update a remote link")
+}
+
+func TestIssuesServiceUpdateDeleteRemoteLink(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MKY-1/remotelink/10000", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "ci=build&id=42", body["globalId"])
+			assert.NotContains(t, body, "id")
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("/api/2/issue/MKY-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "ci=build&id=42", r.URL.Query().Get("globalId"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	updated, _, err := client.Issues.UpdateRemoteLink(context.Background(), "MKY-1", 10000, &RemoteLink{ID: 10000, GlobalID: "ci=build&id=42"})
+	assert.Nil(t, err)
+	assert.True(t, updated)
+
+	deleted, _, err := client.Issues.DeleteRemoteLink(context.Background(), "MKY-1", 10000)
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+
+	deleted, _, err = client.Issues.DeleteRemoteLinkByGlobalID(context.Background(), "MKY-1", "ci=build&id=42")
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
@@ -0,0 +1,503 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Types of the transitions of a workflow
+const (
+	WorkflowTransitionDirected = "directed"
+	WorkflowTransitionGlobal   = "global"
+	WorkflowTransitionInitial  = "initial"
+)
+
+// TransitionWrap represents the data returned by the API
+type TransitionWrap struct {
+	Expand      string        `json:"expand,omitempty"`
+	Transitions []*Transition `json:"transitions,omitempty"`
+}
+
+// Transition represents a transition of the workflow of an issue. The fields of the
+// transition screen are only returned when transitions.fields is expanded.
+type Transition struct {
+	ID          string                `json:"id,omitempty"`
+	Name        string                `json:"name,omitempty"`
+	To          *IssueStatus          `json:"to,omitempty"`
+	HasScreen   bool                  `json:"hasScreen,omitempty"`
+	Global      bool                  `json:"isGlobal,omitempty"`
+	Initial     bool                  `json:"isInitial,omitempty"`
+	Conditional bool                  `json:"isConditional,omitempty"`
+	Fields      map[string]*FieldMeta `json:"fields,omitempty"`
+}
+
+// RequiredFields returns the ids of the required fields of the transition screen that
+// have no default value
+func (t *Transition) RequiredFields() []string {
+	var required []string
+	for id, field := range t.Fields {
+		if field.Required && !field.HasDefaultValue {
+			required = append(required, id)
+		}
+	}
+	sort.Strings(required)
+	return required
+}
+
+// TransitionsOptions contains all options to list the transitions of an issue
+type TransitionsOptions struct {
+	//Use transitions.fields to return the fields of the transition screens.
+	Expand string `query:"expand"`
+	//Return only the transition with this id.
+	TransitionID string `query:"transitionId"`
+}
+
+// TransitionRequest contains the transition to run and the fields set on its screen
+type TransitionRequest struct {
+	TransitionID string
+	Fields       map[string]interface{}
+	Update       map[string][]*FieldOperation
+	//A comment added to the issue with the transition.
+	Comment string
+}
+
+// Workflow represents a workflow with its transitions
+type Workflow struct {
+	Name        string
+	Transitions []*WorkflowTransition
+}
+
+// WorkflowTransition represents a transition of a workflow. Global transitions are
+// available from every status.
+type WorkflowTransition struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	//The ids of the statuses the transition is available from.
+	From []string `json:"from,omitempty"`
+	//The id of the status the transition leads to.
+	To string `json:"to,omitempty"`
+	//directed, global or initial.
+	Type string `json:"type,omitempty"`
+}
+
+// availableFrom reports whether the transition can be run from the status
+func (t *WorkflowTransition) availableFrom(statusID string) bool {
+	if t.Type == WorkflowTransitionInitial || t.To == statusID {
+		return false
+	}
+	return t.Type == WorkflowTransitionGlobal || contains(t.From, statusID)
+}
+
+// TransitionToOptions contains the options to move an issue to a status
+type TransitionToOptions struct {
+	//The values of the fields of the transition screens, by field id, e.g. resolution.
+	//A transition is only sent the values of the fields of its screen.
+	Fields map[string]interface{}
+	//The workflow of the issue, fetched with GetWorkflow when nil.
+	Workflow *Workflow
+}
+
+// TransitionError is returned by TransitionTo when the target status can not be reached
+type TransitionError struct {
+	Issue  string
+	Target string
+	Reason string
+	//The status the issue is in.
+	Status *IssueStatus
+	//The required fields without a value of the transitions that were not run, by
+	//transition name.
+	MissingFields map[string][]string
+}
+
+func (e *TransitionError) Error() string {
+	msg := fmt.Sprintf("jira: cannot transition %s to %s, %s", e.Issue, e.Target, e.Reason)
+
+	names := make([]string, 0, len(e.MissingFields))
+	for name := range e.MissingFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		msg += fmt.Sprintf("; transition %s requires %s", name, strings.Join(e.MissingFields[name], ", "))
+	}
+
+	return msg
+}
+
+// ListTransitions returns the transitions available from the current status of the issue.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/transitions
+func (i *IssuesService) ListTransitions(ctx context.Context, idOrKey string, opts *TransitionsOptions) ([]*Transition, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sissue/%s/transitions%s", apiPath, idOrKey, q), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &TransitionWrap{}
+	resp, err := i.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wrap.Transitions, resp, nil
+}
+
+// DoTransition runs a transition of the issue, setting the fields of the transition
+// screen and adding the comment when it is not empty.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/transitions
+func (i *IssuesService) DoTransition(ctx context.Context, idOrKey string, transition *TransitionRequest) (bool, *Response, error) {
+
+	body := map[string]interface{}{
+		"transition": map[string]string{"id": transition.TransitionID},
+	}
+	if len(transition.Fields) > 0 {
+		body["fields"] = transition.Fields
+	}
+
+	update := map[string][]*FieldOperation{}
+	for id, ops := range transition.Update {
+		update[id] = ops
+	}
+	if transition.Comment != "" {
+		comment := &FieldOperation{Op: FieldOperationAdd, Value: map[string]string{"body": transition.Comment}}
+		update["comment"] = append(update["comment"], comment)
+	}
+	if len(update) > 0 {
+		body["update"] = update
+	}
+
+	req, err := i.client.NewRequest("POST", fmt.Sprintf("%sissue/%s/transitions", apiPath, idOrKey), body)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// transitionTarget is a status name or a status category
+type transitionTarget struct {
+	name     string
+	category string
+}
+
+func (t *transitionTarget) matches(status *IssueStatus) bool {
+	if status == nil {
+		return false
+	}
+	if t.name != "" {
+		return strings.EqualFold(status.Name, t.name)
+	}
+	return status.Category != nil && status.Category.Key == t.category
+}
+
+// resolveTarget returns the target for a status name, or for a status category key or
+// name, with all statuses by id
+func (i *IssuesService) resolveTarget(ctx context.Context, target string) (*transitionTarget, map[string]*IssueStatus, *Response, error) {
+	statuses, resp, err := i.client.Statuses.List(ctx)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	byID := make(map[string]*IssueStatus, len(statuses))
+	for _, s := range statuses {
+		byID[s.ID] = s
+	}
+
+	for _, s := range statuses {
+		if strings.EqualFold(s.Name, target) {
+			t := &transitionTarget{name: s.Name}
+			if s.Category != nil {
+				t.category = s.Category.Key
+			}
+			return t, byID, resp, nil
+		}
+	}
+
+	for _, s := range statuses {
+		if s.Category != nil && (strings.EqualFold(s.Category.Key, target) || strings.EqualFold(s.Category.Name, target)) {
+			return &transitionTarget{category: s.Category.Key}, byID, resp, nil
+		}
+	}
+
+	return nil, nil, resp, fmt.Errorf("jira: %s is neither a status nor a status category", target)
+}
+
+// GetWorkflow returns the workflow of the issue, the workflow that the workflow scheme of
+// its project assigns to its issue type. Only available on Jira Cloud.
+//
+// GET /rest/agile/1.0/issue/{issueIdOrKey}
+// GET /rest/api/2/workflowscheme/project
+// GET /rest/api/2/workflow/search
+func (i *IssuesService) GetWorkflow(ctx context.Context, idOrKey string) (*Workflow, *Response, error) {
+
+	issue, resp, err := i.Get(ctx, idOrKey, &GetIssueOptions{Fields: "project,issuetype"})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i.workflowOf(ctx, issue)
+}
+
+// workflowOf returns the workflow of the issue, which must have its project and issue type
+func (i *IssuesService) workflowOf(ctx context.Context, issue *Issue) (*Workflow, *Response, error) {
+
+	if issue.Fields == nil || issue.Fields.Project == nil {
+		return nil, nil, fmt.Errorf("jira: the project of issue %s is unknown", issue.Key)
+	}
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sworkflowscheme/project?projectId=%s", apiPath, issue.Fields.Project.ID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var schemes struct {
+		Values []struct {
+			WorkflowScheme struct {
+				DefaultWorkflow   string            `json:"defaultWorkflow"`
+				IssueTypeMappings map[string]string `json:"issueTypeMappings"`
+			} `json:"workflowScheme"`
+		} `json:"values"`
+	}
+	resp, err := i.client.Do(ctx, req, &schemes)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(schemes.Values) == 0 {
+		return nil, resp, fmt.Errorf("jira: project %s has no workflow scheme", issue.Fields.Project.ID)
+	}
+
+	scheme := schemes.Values[0].WorkflowScheme
+	name, ok := scheme.IssueTypeMappings[issue.Fields.Type.ID]
+	if !ok {
+		name = scheme.DefaultWorkflow
+	}
+
+	req, err = i.client.NewRequest("GET", fmt.Sprintf("%sworkflow/search?workflowName=%s&expand=transitions", apiPath, url.QueryEscape(name)), nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var workflows struct {
+		Values []struct {
+			Transitions []*WorkflowTransition `json:"transitions"`
+		} `json:"values"`
+	}
+	resp, err = i.client.Do(ctx, req, &workflows)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(workflows.Values) == 0 {
+		return nil, resp, fmt.Errorf("jira: workflow %s not found", name)
+	}
+
+	return &Workflow{Name: name, Transitions: workflows.Values[0].Transitions}, resp, nil
+}
+
+// transitionPath returns the shortest sequence of transitions of the workflow from the
+// status to a status that matches the target, nil when the target can not be reached.
+// Only the given transitions are run from the status, those that can be run right now.
+func transitionPath(workflow *Workflow, from string, target *transitionTarget, statuses map[string]*IssueStatus, runnable map[string]*Transition) []*WorkflowTransition {
+	type step struct {
+		status     string
+		transition *WorkflowTransition
+	}
+
+	// breadth first search, the step leading to each status reached
+	previous := map[string]step{from: {}}
+	queue := []string{from}
+
+	for len(queue) > 0 {
+		status := queue[0]
+		queue = queue[1:]
+
+		if status != from && target.matches(statuses[status]) {
+			var path []*WorkflowTransition
+			for s := status; s != from; s = previous[s].status {
+				path = append([]*WorkflowTransition{previous[s].transition}, path...)
+			}
+			return path
+		}
+
+		for _, t := range workflow.Transitions {
+			if !t.availableFrom(status) {
+				continue
+			}
+			if _, ok := previous[t.To]; ok {
+				continue
+			}
+			if status == from && runnable[t.ID] == nil {
+				continue
+			}
+			previous[t.To] = step{status: status, transition: t}
+			queue = append(queue, t.To)
+		}
+	}
+
+	return nil
+}
+
+// runnableTransitions returns the transitions that can be run with the field values, by
+// id, and the required fields without a value of the other ones, by transition name
+func runnableTransitions(transitions []*Transition, fields map[string]interface{}) (map[string]*Transition, map[string][]string) {
+	runnable := map[string]*Transition{}
+	missing := map[string][]string{}
+
+	for _, t := range transitions {
+		var m []string
+		for _, id := range t.RequiredFields() {
+			if _, ok := fields[id]; !ok {
+				m = append(m, id)
+			}
+		}
+		if len(m) > 0 {
+			missing[t.Name] = m
+			continue
+		}
+		runnable[t.ID] = t
+	}
+
+	return runnable, missing
+}
+
+// screenFields returns the field values of the fields of the transition screen
+func screenFields(t *Transition, fields map[string]interface{}) map[string]interface{} {
+	values := map[string]interface{}{}
+	for id, value := range fields {
+		if _, ok := t.Fields[id]; ok {
+			values[id] = value
+		}
+	}
+	return values
+}
+
+// TransitionTo moves the issue to a status, given by name, or to any status of a status
+// category, given by key or name (e.g. "done" or "Done"), and returns the transitions
+// that were run.
+//
+// The shortest path to the target is searched in the workflow of the issue (see
+// GetWorkflow) before any transition is run, and no transition is run when the target
+// can not be reached. Without the workflow, e.g. on Jira Server, only a transition from
+// the current status to the target is run. Transitions whose screen has required fields
+// are run with the field values of the options, they are left out when a value is
+// missing. Jira only returns the screen fields of the transitions from the current
+// status, so a later transition of the path can still turn out to be missing values, or
+// to be blocked by a condition: the path stops there.
+//
+// A *TransitionError is returned when the target is not reached, with the status the
+// issue is in and the required fields without a value.
+//
+// GET /rest/api/2/status
+// GET /rest/agile/1.0/issue/{issueIdOrKey}
+// GET /rest/api/2/workflowscheme/project
+// GET /rest/api/2/workflow/search
+// GET /rest/api/2/issue/{issueIdOrKey}/transitions
+// POST /rest/api/2/issue/{issueIdOrKey}/transitions
+func (i *IssuesService) TransitionTo(ctx context.Context, idOrKey string, targetStatus string, opts *TransitionToOptions) ([]*Transition, *Response, error) {
+
+	o := TransitionToOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	target, statuses, resp, err := i.resolveTarget(ctx, targetStatus)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	issue, resp, err := i.Get(ctx, idOrKey, &GetIssueOptions{Fields: "status,project,issuetype"})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	current := &IssueStatus{}
+	if issue.Fields != nil && issue.Fields.Status != nil {
+		current = issue.Fields.Status
+	}
+	if target.matches(current) {
+		return nil, resp, nil
+	}
+
+	transitions, resp, err := i.ListTransitions(ctx, idOrKey, &TransitionsOptions{Expand: "transitions.fields"})
+	if err != nil {
+		return nil, resp, err
+	}
+	runnable, missing := runnableTransitions(transitions, o.Fields)
+
+	workflow := o.Workflow
+	if workflow == nil {
+		// without the workflow, only the transitions from the current status are known
+		workflow, resp, err = i.workflowOf(ctx, issue)
+		if err != nil && ctx.Err() != nil {
+			return nil, resp, err
+		}
+	}
+
+	var path []*WorkflowTransition
+	reason := fmt.Sprintf("it can not be reached from %s", current.Name)
+	if workflow != nil {
+		path = transitionPath(workflow, current.ID, target, statuses, runnable)
+	} else {
+		reason = fmt.Sprintf("the workflow is unknown and no transition leads to it from %s", current.Name)
+		for _, t := range transitions {
+			if runnable[t.ID] != nil && target.matches(t.To) {
+				path = []*WorkflowTransition{{ID: t.ID, Name: t.Name}}
+				break
+			}
+		}
+	}
+
+	if path == nil {
+		return nil, resp, &TransitionError{Issue: idOrKey, Target: targetStatus, Reason: reason, Status: current, MissingFields: missing}
+	}
+
+	var run []*Transition
+	for n, step := range path {
+		if n > 0 {
+			transitions, resp, err = i.ListTransitions(ctx, idOrKey, &TransitionsOptions{Expand: "transitions.fields"})
+			if err != nil {
+				return run, resp, err
+			}
+			runnable, missing = runnableTransitions(transitions, o.Fields)
+		}
+
+		t := runnable[step.ID]
+		if t == nil {
+			return run, resp, &TransitionError{Issue: idOrKey, Target: targetStatus, Status: current, MissingFields: missing,
+				Reason: fmt.Sprintf("transition %s can not be run from %s", step.Name, current.Name)}
+		}
+
+		_, resp, err = i.DoTransition(ctx, idOrKey, &TransitionRequest{TransitionID: t.ID, Fields: screenFields(t, o.Fields)})
+		if err != nil {
+			if n == 0 {
+				return run, resp, err
+			}
+			return run, resp, &TransitionError{Issue: idOrKey, Target: targetStatus, Status: current,
+				Reason: fmt.Sprintf("transition %s failed from %s: %v", t.Name, current.Name, err)}
+		}
+
+		run = append(run, t)
+		if t.To != nil {
+			current = t.To
+		}
+	}
+
+	return run, resp, nil
+}
@@ -0,0 +1,318 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuesServiceListTransitions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/transitions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "transitions.fields", r.URL.Query().Get("expand"))
+		fmt.Fprint(w, `{"expand": "transitions","transitions": [{"id": "2","name": "Close Issue","hasScreen": true,
+			"to": {"id": "6","name": "Closed","statusCategory": {"key": "done"}},
+			"fields": {"resolution": {"required": true,"name": "Resolution"},"assignee": {"required": false,"name": "Assignee"}}}]}`)
+	})
+
+	transitions, _, err := client.Issues.ListTransitions(context.Background(), "MCP-1", &TransitionsOptions{Expand: "transitions.fields"})
+	assert.Nil(t, err)
+	assert.Len(t, transitions, 1)
+	assert.Equal(t, "Closed", transitions[0].To.Name)
+	assert.True(t, transitions[0].HasScreen)
+	assert.Equal(t, []string{"resolution"}, transitions[0].RequiredFields())
+}
+
+func TestIssuesServiceDoTransition(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/transitions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{
+			"transition": map[string]interface{}{"id": "2"},
+			"fields":     map[string]interface{}{"resolution": map[string]interface{}{"name": "Fixed"}},
+			"update":     map[string]interface{}{"comment": []interface{}{map[string]interface{}{"add": map[string]interface{}{"body": "Fixed in 1.2"}}}},
+		}, body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	request := &TransitionRequest{
+		TransitionID: "2",
+		Fields:       map[string]interface{}{"resolution": map[string]string{"name": "Fixed"}},
+		Comment:      "Fixed in 1.2",
+	}
+
+	done, _, err := client.Issues.DoTransition(context.Background(), "MCP-1", request)
+	assert.Nil(t, err)
+	assert.True(t, done)
+}
+
+// testTransition is a transition of the workflow served by testWorkflow
+type testTransition struct {
+	id, name, from, to string
+	//The JSON of the screen fields.
+	fields string
+}
+
+// testWorkflow serves the statuses of Jira, the workflow of issue MCP-1 and its
+// transitions, and records the transitions run
+type testWorkflow struct {
+	statuses    map[string]string
+	transitions []testTransition
+	//The workflow API is not available, as on Jira Server.
+	unknown bool
+	//Transitions not available because of a condition.
+	hidden map[string]bool
+
+	status string
+	run    []string
+	fields []map[string]interface{}
+}
+
+func (w *testWorkflow) handle(t *testing.T, mux *http.ServeMux) {
+	mux.HandleFunc("/api/2/status", func(rw http.ResponseWriter, r *http.Request) {
+		var statuses []string
+		for _, s := range w.statuses {
+			statuses = append(statuses, s)
+		}
+		fmt.Fprintf(rw, "[%s]", strings.Join(statuses, ","))
+	})
+
+	mux.HandleFunc("/issue/MCP-1", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "status,project,issuetype", r.URL.Query().Get("fields"))
+		fmt.Fprintf(rw, `{"key": "MCP-1","fields": {"status": %s,"project": {"id": "10000"},"issuetype": {"id": "10001"}}}`, w.statuses[w.status])
+	})
+
+	mux.HandleFunc("/api/2/workflowscheme/project", func(rw http.ResponseWriter, r *http.Request) {
+		if w.unknown {
+			http.NotFound(rw, r)
+			return
+		}
+		assert.Equal(t, "10000", r.URL.Query().Get("projectId"))
+		fmt.Fprint(rw, `{"values": [{"projectIds": ["10000"],"workflowScheme": {"defaultWorkflow": "jira",
+			"issueTypeMappings": {"10001": "Software workflow"}}}]}`)
+	})
+
+	mux.HandleFunc("/api/2/workflow/search", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Software workflow", r.URL.Query().Get("workflowName"))
+		assert.Equal(t, "transitions", r.URL.Query().Get("expand"))
+
+		transitions := []string{`{"id": "1","name": "Create","to": "1","type": "initial"}`}
+		for _, tr := range w.transitions {
+			transitions = append(transitions, fmt.Sprintf(`{"id": "%s","name": "%s","from": ["%s"],"to": "%s","type": "directed"}`, tr.id, tr.name, tr.from, tr.to))
+		}
+		fmt.Fprintf(rw, `{"values": [{"id": {"name": "Software workflow"},"transitions": [%s]}]}`, strings.Join(transitions, ","))
+	})
+
+	mux.HandleFunc("/api/2/issue/MCP-1/transitions", func(rw http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			var transitions []string
+			for _, tr := range w.transitions {
+				if tr.from != w.status || w.hidden[tr.id] {
+					continue
+				}
+				fields := tr.fields
+				if fields == "" {
+					fields = "{}"
+				}
+				transitions = append(transitions, fmt.Sprintf(`{"id": "%s","name": "%s","to": %s,"fields": %s}`, tr.id, tr.name, w.statuses[tr.to], fields))
+			}
+			fmt.Fprintf(rw, `{"transitions": [%s]}`, strings.Join(transitions, ","))
+			return
+		}
+
+		body := struct {
+			Transition struct{ ID string }    `json:"transition"`
+			Fields     map[string]interface{} `json:"fields"`
+		}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.run = append(w.run, body.Transition.ID)
+		w.fields = append(w.fields, body.Fields)
+		for _, tr := range w.transitions {
+			if tr.id == body.Transition.ID {
+				w.status = tr.to
+			}
+		}
+		rw.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// newTestWorkflow returns a workflow To Do -> In Progress -> Review -> Done, where To Do
+// can also be resolved directly, which requires a resolution
+func newTestWorkflow(status string) *testWorkflow {
+	return &testWorkflow{
+		statuses: map[string]string{
+			"1": `{"id": "1","name": "To Do","statusCategory": {"key": "new","name": "To Do"}}`,
+			"3": `{"id": "3","name": "In Progress","statusCategory": {"key": "indeterminate","name": "In Progress"}}`,
+			"4": `{"id": "4","name": "Review","statusCategory": {"key": "indeterminate","name": "In Progress"}}`,
+			"5": `{"id": "5","name": "Done","statusCategory": {"key": "done","name": "Done"}}`,
+		},
+		transitions: []testTransition{
+			{id: "11", name: "Start", from: "1", to: "3"},
+			{id: "12", name: "Resolve", from: "1", to: "5", fields: `{"resolution": {"required": true},"comment": {"required": false}}`},
+			{id: "31", name: "Stop", from: "3", to: "1"},
+			{id: "32", name: "Review", from: "3", to: "4"},
+			{id: "41", name: "Approve", from: "4", to: "5"},
+			{id: "42", name: "Reject", from: "4", to: "3"},
+		},
+		status: status,
+	}
+}
+
+func TestIssuesServiceTransitionTo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	w := newTestWorkflow("1")
+	w.handle(t, mux)
+
+	path, _, err := client.Issues.TransitionTo(context.Background(), "MCP-1", "Done", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"11", "32", "41"}, w.run)
+	assert.Len(t, path, 3)
+	assert.Equal(t, "Approve", path[2].Name)
+
+	// already in the target status
+	path, _, err = client.Issues.TransitionTo(context.Background(), "MCP-1", "done", nil)
+	assert.Nil(t, err)
+	assert.Empty(t, path)
+
+	// to a status category
+	w.status, w.run = "1", nil
+	_, _, err = client.Issues.TransitionTo(context.Background(), "MCP-1", "In Progress", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"11"}, w.run)
+
+	w.status, w.run = "1", nil
+	_, _, err = client.Issues.TransitionTo(context.Background(), "MCP-1", "indeterminate", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"11"}, w.run)
+}
+
+func TestIssuesServiceTransitionToWithFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	w := newTestWorkflow("1")
+	w.handle(t, mux)
+
+	opts := &TransitionToOptions{Fields: map[string]interface{}{
+		"resolution":  map[string]string{"name": "Fixed"},
+		"fixVersions": []string{"1.2"},
+	}}
+	path, _, err := client.Issues.TransitionTo(context.Background(), "MCP-1", "Done", opts)
+	assert.Nil(t, err)
+	assert.Len(t, path, 1)
+	assert.Equal(t, []string{"12"}, w.run)
+	// only the fields of the transition screen are sent
+	assert.Equal(t, map[string]interface{}{"resolution": map[string]interface{}{"name": "Fixed"}}, w.fields[0])
+}
+
+func TestIssuesServiceTransitionToDeadEnd(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	w := &testWorkflow{
+		statuses: map[string]string{
+			"1": `{"id": "1","name": "To Do","statusCategory": {"key": "new"}}`,
+			"2": `{"id": "2","name": "Triage","statusCategory": {"key": "indeterminate"}}`,
+			"3": `{"id": "3","name": "In Progress","statusCategory": {"key": "indeterminate"}}`,
+			"5": `{"id": "5","name": "Done","statusCategory": {"key": "done"}}`,
+		},
+		transitions: []testTransition{
+			// Triage is as close to Done as In Progress, but leads nowhere
+			{id: "12", name: "Triage", from: "1", to: "2"},
+			{id: "13", name: "Start", from: "1", to: "3"},
+			{id: "35", name: "Finish", from: "3", to: "5"},
+		},
+		status: "1",
+	}
+	w.handle(t, mux)
+
+	path, _, err := client.Issues.TransitionTo(context.Background(), "MCP-1", "Done", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"13", "35"}, w.run)
+	assert.Equal(t, "Finish", path[1].Name)
+}
+
+func TestIssuesServiceTransitionToUnreachable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	w := newTestWorkflow("5")
+	w.handle(t, mux)
+
+	_, _, err := client.Issues.TransitionTo(context.Background(), "MCP-1", "To Do", nil)
+	terr, ok := err.(*TransitionError)
+	assert.True(t, ok)
+	assert.Equal(t, "jira: cannot transition MCP-1 to To Do, it can not be reached from Done", terr.Error())
+	assert.Equal(t, "Done", terr.Status.Name)
+
+	_, _, err = client.Issues.TransitionTo(context.Background(), "MCP-1", "Unknown", nil)
+	assert.NotNil(t, err)
+	assert.Empty(t, w.run)
+}
+
+func TestIssuesServiceTransitionToBlockedOnTheWay(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	w := newTestWorkflow("1")
+	w.hidden = map[string]bool{"32": true}
+	w.handle(t, mux)
+
+	path, _, err := client.Issues.TransitionTo(context.Background(), "MCP-1", "Done", nil)
+	terr, ok := err.(*TransitionError)
+	assert.True(t, ok)
+	assert.Equal(t, "jira: cannot transition MCP-1 to Done, transition Review can not be run from In Progress", terr.Error())
+	assert.Equal(t, "In Progress", terr.Status.Name)
+	assert.Len(t, path, 1)
+	assert.Equal(t, []string{"11"}, w.run)
+}
+
+func TestIssuesServiceTransitionToUnknownWorkflow(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	w := newTestWorkflow("1")
+	w.unknown = true
+	w.handle(t, mux)
+
+	// Done is only reached directly, with a resolution
+	_, _, err := client.Issues.TransitionTo(context.Background(), "MCP-1", "Done", nil)
+	terr, ok := err.(*TransitionError)
+	assert.True(t, ok)
+	assert.Equal(t, "jira: cannot transition MCP-1 to Done, the workflow is unknown and no transition leads to it from To Do; transition Resolve requires resolution", terr.Error())
+	assert.Empty(t, w.run)
+
+	// Review is not reached without exploring the workflow
+	_, _, err = client.Issues.TransitionTo(context.Background(), "MCP-1", "Review", nil)
+	assert.IsType(t, &TransitionError{}, err)
+	assert.Empty(t, w.run)
+
+	opts := &TransitionToOptions{Fields: map[string]interface{}{"resolution": map[string]string{"name": "Fixed"}}}
+	_, _, err = client.Issues.TransitionTo(context.Background(), "MCP-1", "Done", opts)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"12"}, w.run)
+}
+
+func TestTransitionErrorMissingFields(t *testing.T) {
+	err := &TransitionError{
+		Issue:         "MCP-1",
+		Target:        "Done",
+		Reason:        "no transition leads to a new status from To Do",
+		MissingFields: map[string][]string{"Resolve": {"resolution", "fixVersions"}},
+	}
+	assert.Equal(t, "jira: cannot transition MCP-1 to Done, no transition leads to a new status from To Do; transition Resolve requires resolution, fixVersions", err.Error())
+}
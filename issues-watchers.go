@@ -0,0 +1,232 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ListWatchers returns the watchers of the issue.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/watchers
+func (i *IssuesService) ListWatchers(ctx context.Context, idOrKey string) (*IssueWatch, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sissue/%s/watchers", apiPath, idOrKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var watch = &IssueWatch{}
+	resp, err := i.client.Do(ctx, req, watch)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return watch, resp, nil
+}
+
+// AddWatcher adds a user to the watchers of the issue, defined by its AccountID (Jira
+// Cloud) or its Name (Jira Server).
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/watchers
+func (i *IssuesService) AddWatcher(ctx context.Context, idOrKey string, user *IssueUser) (bool, *Response, error) {
+
+	_, id, err := userID(user)
+	if err != nil {
+		return false, nil, err
+	}
+
+	// the body is the account id or the name of the user, as a JSON string
+	req, err := i.client.NewRequest("POST", fmt.Sprintf("%sissue/%s/watchers", apiPath, idOrKey), id)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// RemoveWatcher removes a user from the watchers of the issue, defined by its AccountID
+// (Jira Cloud) or its Name (Jira Server).
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}/watchers
+func (i *IssuesService) RemoveWatcher(ctx context.Context, idOrKey string, user *IssueUser) (bool, *Response, error) {
+
+	param, id, err := userID(user)
+	if err != nil {
+		return false, nil, err
+	}
+
+	req, err := i.client.NewRequest("DELETE", fmt.Sprintf("%sissue/%s/watchers?%s=%s", apiPath, idOrKey, param, url.QueryEscape(id)), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// GetVotes returns the votes of the issue, with the voters when the user has permission
+// to view them.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/votes
+func (i *IssuesService) GetVotes(ctx context.Context, idOrKey string) (*IssueVote, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sissue/%s/votes", apiPath, idOrKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var vote = &IssueVote{}
+	resp, err := i.client.Do(ctx, req, vote)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return vote, resp, nil
+}
+
+// Vote adds the vote of the user to the issue.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/votes
+func (i *IssuesService) Vote(ctx context.Context, idOrKey string) (bool, *Response, error) {
+	return i.vote(ctx, "POST", idOrKey)
+}
+
+// Unvote removes the vote of the user from the issue.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}/votes
+func (i *IssuesService) Unvote(ctx context.Context, idOrKey string) (bool, *Response, error) {
+	return i.vote(ctx, "DELETE", idOrKey)
+}
+
+func (i *IssuesService) vote(ctx context.Context, method string, idOrKey string) (bool, *Response, error) {
+
+	req, err := i.client.NewRequest(method, fmt.Sprintf("%sissue/%s/votes", apiPath, idOrKey), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// BulkWatch adds the users to the watchers of every issue, with one entry per issue and
+// user in the result. Failures reported by the API are recorded in the result with the
+// user that could not be added and the remaining issues are still processed. Any other
+// error, e.g. a canceled context, stops the operation and is returned.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/watchers
+func (i *IssuesService) BulkWatch(ctx context.Context, issueKeys []string, users []*IssueUser) (*BulkResult, *Response, error) {
+
+	for _, user := range users {
+		if _, _, err := userID(user); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	result := &BulkResult{}
+	var resp *Response
+
+	for _, key := range issueKeys {
+		for _, user := range users {
+			var err error
+			_, resp, err = i.AddWatcher(ctx, key, user)
+			if _, ok := err.(*ErrorResponse); err != nil && !ok {
+				return result, resp, err
+			}
+
+			n := len(result.Entries)
+			result.add([]string{key}, resp, err)
+			if err != nil {
+				_, id, _ := userID(user)
+				entry := &result.Entries[n]
+				entry.Errors = append([]string{"watcher: " + id}, entry.Errors...)
+			}
+		}
+	}
+
+	return result, resp, nil
+}
+
+// keysOf returns the keys of the issues
+func keysOf(issues []*Issue) []string {
+	keys := make([]string, len(issues))
+	for n, issue := range issues {
+		keys[n] = issue.Key
+	}
+	return keys
+}
+
+// BulkWatch adds the users to the watchers of every issue of the sprint (see
+// IssuesService.BulkWatch).
+//
+// GET /rest/agile/1.0/sprint/{sprintId}/issue
+// POST /rest/api/2/issue/{issueIdOrKey}/watchers
+func (s *SprintsService) BulkWatch(ctx context.Context, sprintID int, users []*IssueUser) (*BulkResult, *Response, error) {
+
+	list := func(ctx context.Context, o *IssuesOptions) ([]*Issue, *Response, error) {
+		return s.ListIssues(ctx, sprintID, o)
+	}
+
+	issues, resp, err := listAllIssues(ctx, &IssuesOptions{Fields: "summary"}, list)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result, watchResp, err := s.client.Issues.BulkWatch(ctx, keysOf(issues), users)
+	if watchResp != nil {
+		resp = watchResp
+	}
+
+	return result, resp, err
+}
+
+// BulkWatch adds the users to the watchers of every issue of the epic (see
+// IssuesService.BulkWatch).
+//
+// GET /rest/agile/1.0/epic/{epicIdOrKey}/issue
+// POST /rest/api/2/issue/{issueIdOrKey}/watchers
+func (e *EpicsService) BulkWatch(ctx context.Context, idOrKey string, users []*IssueUser) (*BulkResult, *Response, error) {
+
+	list := func(ctx context.Context, o *IssuesOptions) ([]*Issue, *Response, error) {
+		return e.ListIssues(ctx, idOrKey, o)
+	}
+
+	issues, resp, err := listAllIssues(ctx, &IssuesOptions{Fields: "summary"}, list)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result, watchResp, err := e.client.Issues.BulkWatch(ctx, keysOf(issues), users)
+	if watchResp != nil {
+		resp = watchResp
+	}
+
+	return result, resp, err
+}
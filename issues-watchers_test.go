@@ -0,0 +1,125 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuesServiceWatchers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var added []string
+	mux.HandleFunc("/api/2/issue/MCP-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"isWatching": false,"watchCount": 1,"watchers": [{"name": "fred","displayName": "Fred F. User"}]}`)
+		case "POST":
+			var id string
+			json.NewDecoder(r.Body).Decode(&id)
+			added = append(added, id)
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE":
+			assert.Equal(t, "5b10a2844c20165700ede21g", r.URL.Query().Get("accountId"))
+			assert.Empty(t, r.URL.Query().Get("username"))
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	watch, _, err := client.Issues.ListWatchers(context.Background(), "MCP-1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, watch.Count)
+	assert.Equal(t, "fred", watch.Watchers[0].Name)
+
+	ok, _, err := client.Issues.AddWatcher(context.Background(), "MCP-1", &IssueUser{Name: "fred"})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, _, err = client.Issues.AddWatcher(context.Background(), "MCP-1", &IssueUser{Name: "fred", AccountID: "5b10a2844c20165700ede21g"})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"fred", "5b10a2844c20165700ede21g"}, added)
+
+	ok, _, err = client.Issues.RemoveWatcher(context.Background(), "MCP-1", &IssueUser{AccountID: "5b10a2844c20165700ede21g"})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	_, _, err = client.Issues.AddWatcher(context.Background(), "MCP-1", &IssueUser{DisplayName: "Fred F. User"})
+	assert.EqualError(t, err, "jira: a user is identified by its account id or its name")
+}
+
+func TestIssuesServiceVotes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var methods []string
+	mux.HandleFunc("/api/2/issue/MCP-1/votes", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"votes": 2,"hasVoted": true,"voters": [{"name": "fred"},{"name": "mia"}]}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	vote, _, err := client.Issues.GetVotes(context.Background(), "MCP-1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, vote.Votes)
+	assert.True(t, vote.Voted)
+	assert.Len(t, vote.Voters, 2)
+
+	ok, _, err := client.Issues.Vote(context.Background(), "MCP-1")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, _, err = client.Issues.Unvote(context.Background(), "MCP-1")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"GET", "POST", "DELETE"}, methods)
+}
+
+func TestSprintsServiceBulkWatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/37/issue", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"isLast": true,"issues": [{"key": "MCP-1"},{"key": "MCP-2"}]}`)
+	})
+
+	var watched []string
+	watchers := func(key string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var id string
+			json.NewDecoder(r.Body).Decode(&id)
+			if id == "mia" && key == "MCP-2" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"errorMessages": ["The user \"mia\" does not have permission to view this issue."]}`)
+				return
+			}
+			watched = append(watched, key+" "+id)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+	mux.HandleFunc("/api/2/issue/MCP-1/watchers", watchers("MCP-1"))
+	mux.HandleFunc("/api/2/issue/MCP-2/watchers", watchers("MCP-2"))
+
+	users := []*IssueUser{{Name: "fred"}, {Name: "mia"}}
+	result, _, err := client.Sprints.BulkWatch(context.Background(), 37, users)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"MCP-1 fred", "MCP-1 mia", "MCP-2 fred"}, watched)
+	assert.Len(t, result.Entries, 4)
+	assert.False(t, result.OK())
+
+	failed := result.Failed()
+	assert.Len(t, failed, 1)
+	assert.Equal(t, "MCP-2", failed[0].Key)
+	assert.Equal(t, []string{"watcher: mia", `The user "mia" does not have permission to view this issue.`}, failed[0].Errors)
+
+	_, _, err = client.Sprints.BulkWatch(context.Background(), 37, []*IssueUser{{}})
+	assert.EqualError(t, err, "jira: a user is identified by its account id or its name")
+}
@@ -0,0 +1,393 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Ways to adjust the remaining estimate of an issue when a worklog is added, updated or deleted
+const (
+	//Sets the remaining estimate to NewEstimate.
+	AdjustEstimateNew = "new"
+	//Leaves the remaining estimate unchanged.
+	AdjustEstimateLeave = "leave"
+	//Reduces the remaining estimate by ReduceBy, or increases it by IncreaseBy on delete.
+	AdjustEstimateManual = "manual"
+	//Adjusts the remaining estimate by the time spent of the worklog, the default.
+	AdjustEstimateAuto = "auto"
+)
+
+// MaxWorklogIDs is the maximum number of worklogs returned by a single GetWorklogs request
+const MaxWorklogIDs = 1000
+
+// WorklogRequest contains the fields of a worklog to add or update
+type WorklogRequest struct {
+	Comment string `json:"comment,omitempty"`
+	//The date and time at which the work started, now when nil.
+	Started *DateTime `json:"started,omitempty"`
+	//The time spent in Jira duration format, e.g. 3h 20m. Required when TimeSpentSeconds is not set.
+	TimeSpent        string `json:"timeSpent,omitempty"`
+	TimeSpentSeconds int    `json:"timeSpentSeconds,omitempty"`
+	//Restricts the worklog to a role or a group, visible to all users when nil.
+	Visibility *CommentVisibility `json:"visibility,omitempty"`
+	Properties []*Property        `json:"properties,omitempty"`
+}
+
+// WorklogsOptions contains all options to list the worklogs of an issue
+type WorklogsOptions struct {
+	//The starting index of the returned worklogs. Base index: 0.
+	StartAt int `query:"startAt"`
+	//The maximum number of worklogs to return per page. Default: 5000.
+	MaxResults int `query:"maxResults"`
+	//Use properties to return the properties of the worklogs.
+	Expand string `query:"expand"`
+}
+
+// WorklogOptions contains the options to add, update or delete a worklog
+type WorklogOptions struct {
+	//How the remaining estimate of the issue is adjusted: new, leave, manual or auto.
+	AdjustEstimate string `query:"adjustEstimate"`
+	//The new remaining estimate, required when AdjustEstimate is new, e.g. 2d.
+	NewEstimate string `query:"newEstimate"`
+	//The amount to reduce the remaining estimate by when a worklog is added or updated, required when AdjustEstimate is manual.
+	ReduceBy string `query:"reduceBy"`
+	//The amount to increase the remaining estimate by when a worklog is deleted, required when AdjustEstimate is manual.
+	IncreaseBy string `query:"increaseBy"`
+	//Use properties to return the properties of the worklog.
+	Expand string `query:"expand"`
+}
+
+// validate checks that the estimate required by the adjust estimate mode is set
+func (o *WorklogOptions) validate(deleting bool) error {
+	if o == nil {
+		return nil
+	}
+
+	switch o.AdjustEstimate {
+	case "", AdjustEstimateLeave, AdjustEstimateAuto:
+		return nil
+	case AdjustEstimateNew:
+		if o.NewEstimate == "" {
+			return fmt.Errorf("jira: adjustEstimate %s requires newEstimate", o.AdjustEstimate)
+		}
+		return nil
+	case AdjustEstimateManual:
+		if deleting && o.IncreaseBy == "" {
+			return fmt.Errorf("jira: adjustEstimate %s requires increaseBy", o.AdjustEstimate)
+		}
+		if !deleting && o.ReduceBy == "" {
+			return fmt.Errorf("jira: adjustEstimate %s requires reduceBy", o.AdjustEstimate)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("jira: invalid adjustEstimate %s", o.AdjustEstimate)
+}
+
+// WorklogChange represents a worklog updated or deleted, as returned by the worklog feeds
+type WorklogChange struct {
+	WorklogID int `json:"worklogId"`
+	//The time of the change, in milliseconds since the epoch.
+	UpdatedTime int64       `json:"updatedTime,omitempty"`
+	Properties  []*Property `json:"properties,omitempty"`
+}
+
+// WorklogChangePage represents a page of the worklog feeds
+type WorklogChangePage struct {
+	Values []*WorklogChange `json:"values,omitempty"`
+	//The bounds of the page, in milliseconds since the epoch.
+	Since    int64  `json:"since,omitempty"`
+	Until    int64  `json:"until,omitempty"`
+	SelfLink string `json:"self,omitempty"`
+	NextPage string `json:"nextPage,omitempty"`
+	LastPage bool   `json:"lastPage,omitempty"`
+}
+
+// WorklogSync contains the worklogs updated and deleted since a point in time
+type WorklogSync struct {
+	Updated []*IssueWorklog
+	Deleted []*WorklogChange
+	//The time to sync from next time.
+	Until time.Time
+}
+
+// ListWorklogs returns a page of the worklogs of the issue.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/worklog
+func (i *IssuesService) ListWorklogs(ctx context.Context, idOrKey string, opts *WorklogsOptions) ([]*IssueWorklog, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sissue/%s/worklog%s", apiPath, idOrKey, q), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &IssueWorklogWrap{}
+	resp, err := i.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.MaxResults = wrap.MaxResults
+	resp.StartAt = wrap.StartAt
+	resp.IsLast = wrap.StartAt+len(wrap.Worklogs) >= wrap.Total
+
+	return wrap.Worklogs, resp, nil
+}
+
+// GetWorklog returns a worklog of the issue.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/worklog/{id}
+func (i *IssuesService) GetWorklog(ctx context.Context, idOrKey string, worklogID string) (*IssueWorklog, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sissue/%s/worklog/%s", apiPath, idOrKey, worklogID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var worklog = &IssueWorklog{}
+	resp, err := i.client.Do(ctx, req, worklog)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return worklog, resp, nil
+}
+
+// AddWorklog adds a worklog to the issue, adjusting its remaining estimate as defined
+// by the options.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/worklog
+func (i *IssuesService) AddWorklog(ctx context.Context, idOrKey string, worklog *WorklogRequest, opts *WorklogOptions) (*IssueWorklog, *Response, error) {
+
+	if err := opts.validate(false); err != nil {
+		return nil, nil, err
+	}
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("POST", fmt.Sprintf("%sissue/%s/worklog%s", apiPath, idOrKey, q), worklog)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var added = &IssueWorklog{}
+	resp, err := i.client.Do(ctx, req, added)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return added, resp, nil
+}
+
+// UpdateWorklog updates a worklog of the issue, adjusting its remaining estimate as
+// defined by the options. Jira does not support AdjustEstimateManual on update.
+//
+// PUT /rest/api/2/issue/{issueIdOrKey}/worklog/{id}
+func (i *IssuesService) UpdateWorklog(ctx context.Context, idOrKey string, worklogID string, worklog *WorklogRequest, opts *WorklogOptions) (*IssueWorklog, *Response, error) {
+
+	if opts != nil && opts.AdjustEstimate == AdjustEstimateManual {
+		return nil, nil, fmt.Errorf("jira: adjustEstimate %s is not supported on update", opts.AdjustEstimate)
+	}
+	if err := opts.validate(false); err != nil {
+		return nil, nil, err
+	}
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("PUT", fmt.Sprintf("%sissue/%s/worklog/%s%s", apiPath, idOrKey, worklogID, q), worklog)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated = &IssueWorklog{}
+	resp, err := i.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, nil
+}
+
+// DeleteWorklog deletes a worklog of the issue, adjusting its remaining estimate as
+// defined by the options.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}/worklog/{id}
+func (i *IssuesService) DeleteWorklog(ctx context.Context, idOrKey string, worklogID string, opts *WorklogOptions) (bool, *Response, error) {
+
+	if err := opts.validate(true); err != nil {
+		return false, nil, err
+	}
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("DELETE", fmt.Sprintf("%sissue/%s/worklog/%s%s", apiPath, idOrKey, worklogID, q), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// toMillis returns the time in milliseconds since the epoch
+func toMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// fromMillis returns the time for milliseconds since the epoch
+func fromMillis(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+// listWorklogChanges returns a page of a worklog feed
+func (i *IssuesService) listWorklogChanges(ctx context.Context, feed string, since time.Time) (*WorklogChangePage, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", fmt.Sprintf("%sworklog/%s?since=%d", apiPath, feed, toMillis(since)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var page = &WorklogChangePage{}
+	resp, err := i.client.Do(ctx, req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.IsLast = page.LastPage
+
+	return page, resp, nil
+}
+
+// ListUpdatedWorklogs returns a page of the ids of the worklogs created or updated
+// since the given time, across all issues, oldest first. The next page starts at the
+// Until time of the page.
+//
+// GET /rest/api/2/worklog/updated
+func (i *IssuesService) ListUpdatedWorklogs(ctx context.Context, since time.Time) (*WorklogChangePage, *Response, error) {
+	return i.listWorklogChanges(ctx, "updated", since)
+}
+
+// ListDeletedWorklogs returns a page of the ids of the worklogs deleted since the given
+// time, across all issues, oldest first. The next page starts at the Until time of the
+// page.
+//
+// GET /rest/api/2/worklog/deleted
+func (i *IssuesService) ListDeletedWorklogs(ctx context.Context, since time.Time) (*WorklogChangePage, *Response, error) {
+	return i.listWorklogChanges(ctx, "deleted", since)
+}
+
+// GetWorklogs returns the worklogs for the given ids, in chunks of MaxWorklogIDs.
+// Worklogs that do not exist or are not visible to the user are left out.
+//
+// POST /rest/api/2/worklog/list
+func (i *IssuesService) GetWorklogs(ctx context.Context, worklogIDs []int) ([]*IssueWorklog, *Response, error) {
+
+	var all []*IssueWorklog
+	var resp *Response
+
+	for start := 0; start < len(worklogIDs); start += MaxWorklogIDs {
+		end := start + MaxWorklogIDs
+		if end > len(worklogIDs) {
+			end = len(worklogIDs)
+		}
+
+		req, err := i.client.NewRequest("POST", apiPath+"worklog/list", map[string][]int{"ids": worklogIDs[start:end]})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var worklogs []*IssueWorklog
+		resp, err = i.client.Do(ctx, req, &worklogs)
+		if err != nil {
+			return nil, resp, err
+		}
+		all = append(all, worklogs...)
+	}
+
+	return all, resp, nil
+}
+
+// allWorklogChanges pages through a worklog feed until its last page and returns the
+// changes with the Until time of the last page. An error is returned when a page that is
+// not the last one does not move the Until time forward, the same page would be requested
+// again and again otherwise.
+func (i *IssuesService) allWorklogChanges(ctx context.Context, feed string, since time.Time) ([]*WorklogChange, time.Time, *Response, error) {
+	var all []*WorklogChange
+	until := since
+
+	for {
+		page, resp, err := i.listWorklogChanges(ctx, feed, until)
+		if err != nil {
+			return nil, since, resp, err
+		}
+		all = append(all, page.Values...)
+		if page.LastPage || len(page.Values) == 0 {
+			if page.Until != 0 {
+				until = fromMillis(page.Until)
+			}
+			return all, until, resp, nil
+		}
+
+		if page.Until <= toMillis(until) {
+			return nil, since, resp, fmt.Errorf("jira: worklog %s feed does not advance past %d", feed, toMillis(until))
+		}
+		until = fromMillis(page.Until)
+	}
+}
+
+// SyncWorklogs returns the worklogs created or updated and the ids of the worklogs deleted
+// since the given time, across all issues, without scanning the issues. The Until time of
+// the result is the time to sync from next time. Jira leaves out the changes of the last
+// minute, so that worklogs being saved are not missed.
+//
+// GET /rest/api/2/worklog/updated
+// GET /rest/api/2/worklog/deleted
+// POST /rest/api/2/worklog/list
+func (i *IssuesService) SyncWorklogs(ctx context.Context, since time.Time) (*WorklogSync, *Response, error) {
+
+	updated, until, resp, err := i.allWorklogChanges(ctx, "updated", since)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	deleted, deletedUntil, resp, err := i.allWorklogChanges(ctx, "deleted", since)
+	if err != nil {
+		return nil, resp, err
+	}
+	// resume from the earliest feed, changes already seen are returned again and are
+	// expected to be applied idempotently
+	if deletedUntil.Before(until) {
+		until = deletedUntil
+	}
+
+	sync := &WorklogSync{Deleted: deleted, Until: until}
+
+	if len(updated) > 0 {
+		ids := make([]int, len(updated))
+		for n, change := range updated {
+			ids[n] = change.WorklogID
+		}
+
+		sync.Updated, resp, err = i.GetWorklogs(ctx, ids)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return sync, resp, nil
+}
@@ -0,0 +1,173 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const worklogJSON = `{"self": "http://www.example.com/jira/rest/api/2/issue/10010/worklog/10000","id": "100028",
+	"issueId": "10002","author": {"name": "fred"},"comment": "I did some work here.",
+	"started": "2019-09-02T09:30:00.000+0000","timeSpent": "3h 20m","timeSpentSeconds": 12000,
+	"visibility": {"type": "group","value": "jira-developers"}}`
+
+func TestIssuesServiceListWorklogs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprintf(w, `{"startAt": 0,"maxResults": 1,"total": 1,"worklogs": [%s]}`, worklogJSON)
+	})
+
+	worklogs, resp, err := client.Issues.ListWorklogs(context.Background(), "MCP-1", nil)
+	assert.Nil(t, err)
+	assert.True(t, resp.IsLast)
+	assert.Len(t, worklogs, 1)
+	assert.Equal(t, "100028", worklogs[0].ID)
+	assert.Equal(t, 12000, worklogs[0].TimeSpentSeconds)
+	assert.Equal(t, "jira-developers", worklogs[0].Visibility.Value)
+}
+
+func TestIssuesServiceAddUpdateDeleteWorklog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "new", r.URL.Query().Get("adjustEstimate"))
+		assert.Equal(t, "2d", r.URL.Query().Get("newEstimate"))
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "2019-09-02T09:30:00.000+0000", body["started"])
+		assert.Equal(t, "3h 20m", body["timeSpent"])
+		assert.NotContains(t, body, "timeSpentSeconds")
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, worklogJSON)
+	})
+
+	mux.HandleFunc("/api/2/issue/MCP-1/worklog/100028", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			assert.Equal(t, "leave", r.URL.Query().Get("adjustEstimate"))
+			fmt.Fprint(w, worklogJSON)
+		case "DELETE":
+			assert.Equal(t, "manual", r.URL.Query().Get("adjustEstimate"))
+			assert.Equal(t, "1h", r.URL.Query().Get("increaseBy"))
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	started := DateTime(time.Date(2019, 9, 2, 9, 30, 0, 0, time.UTC))
+	worklog := &WorklogRequest{Started: &started, TimeSpent: "3h 20m"}
+
+	added, _, err := client.Issues.AddWorklog(context.Background(), "MCP-1", worklog, &WorklogOptions{AdjustEstimate: AdjustEstimateNew, NewEstimate: "2d"})
+	assert.Nil(t, err)
+	assert.Equal(t, "100028", added.ID)
+
+	updated, _, err := client.Issues.UpdateWorklog(context.Background(), "MCP-1", "100028", worklog, &WorklogOptions{AdjustEstimate: AdjustEstimateLeave})
+	assert.Nil(t, err)
+	assert.Equal(t, "10002", updated.IssueID)
+
+	deleted, _, err := client.Issues.DeleteWorklog(context.Background(), "MCP-1", "100028", &WorklogOptions{AdjustEstimate: AdjustEstimateManual, IncreaseBy: "1h"})
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
+
+func TestIssuesServiceWorklogInvalidAdjustEstimate(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	worklog := &WorklogRequest{TimeSpent: "1h"}
+
+	_, _, err := client.Issues.AddWorklog(context.Background(), "MCP-1", worklog, &WorklogOptions{AdjustEstimate: AdjustEstimateNew})
+	assert.EqualError(t, err, "jira: adjustEstimate new requires newEstimate")
+
+	_, _, err = client.Issues.AddWorklog(context.Background(), "MCP-1", worklog, &WorklogOptions{AdjustEstimate: AdjustEstimateManual, IncreaseBy: "1h"})
+	assert.EqualError(t, err, "jira: adjustEstimate manual requires reduceBy")
+
+	_, _, err = client.Issues.UpdateWorklog(context.Background(), "MCP-1", "100028", worklog, &WorklogOptions{AdjustEstimate: AdjustEstimateManual, ReduceBy: "1h"})
+	assert.EqualError(t, err, "jira: adjustEstimate manual is not supported on update")
+
+	_, _, err = client.Issues.DeleteWorklog(context.Background(), "MCP-1", "100028", &WorklogOptions{AdjustEstimate: AdjustEstimateManual})
+	assert.EqualError(t, err, "jira: adjustEstimate manual requires increaseBy")
+
+	_, _, err = client.Issues.DeleteWorklog(context.Background(), "MCP-1", "100028", &WorklogOptions{AdjustEstimate: "later"})
+	assert.EqualError(t, err, "jira: invalid adjustEstimate later")
+}
+
+func TestIssuesServiceSyncWorklogs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var updatedSince []string
+	mux.HandleFunc("/api/2/worklog/updated", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		since := r.URL.Query().Get("since")
+		updatedSince = append(updatedSince, since)
+
+		if since == "1438013671000" {
+			fmt.Fprint(w, `{"values": [{"worklogId": 103,"updatedTime": 1438013671562},{"worklogId": 104,"updatedTime": 1438013693136}],
+				"since": 1438013671000,"until": 1438013693136,"lastPage": false}`)
+			return
+		}
+		fmt.Fprint(w, `{"values": [{"worklogId": 105,"updatedTime": 1438013700000}],
+			"since": 1438013693136,"until": 1438013700000,"lastPage": true}`)
+	})
+
+	mux.HandleFunc("/api/2/worklog/deleted", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1438013671000", r.URL.Query().Get("since"))
+		fmt.Fprint(w, `{"values": [{"worklogId": 99,"updatedTime": 1438013690000}],
+			"since": 1438013671000,"until": 1438013690000,"lastPage": true}`)
+	})
+
+	mux.HandleFunc("/api/2/worklog/list", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string][]int
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, []int{103, 104, 105}, body["ids"])
+
+		fmt.Fprint(w, `[{"id": "103"},{"id": "104"},{"id": "105"}]`)
+	})
+
+	since := time.Unix(1438013671, 0)
+	sync, _, err := client.Issues.SyncWorklogs(context.Background(), since)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1438013671000", "1438013693136"}, updatedSince)
+	assert.Len(t, sync.Updated, 3)
+	assert.Equal(t, "105", sync.Updated[2].ID)
+	assert.Len(t, sync.Deleted, 1)
+	assert.Equal(t, 99, sync.Deleted[0].WorklogID)
+	assert.Equal(t, int64(1438013690000), toMillis(sync.Until))
+}
+
+func TestIssuesServiceSyncWorklogsStuckFeed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/api/2/worklog/updated", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		until := "1438013671000"
+		if r.URL.Query().Get("since") == "0" {
+			until = "0"
+		}
+		fmt.Fprintf(w, `{"values": [{"worklogId": 103,"updatedTime": 1438013671000}],"until": %s,"lastPage": false}`, until)
+	})
+
+	_, _, err := client.Issues.SyncWorklogs(context.Background(), time.Unix(1438013671, 0))
+	assert.EqualError(t, err, "jira: worklog updated feed does not advance past 1438013671000")
+	assert.Equal(t, 1, requests)
+
+	_, _, err = client.Issues.SyncWorklogs(context.Background(), time.Time{})
+	assert.EqualError(t, err, "jira: worklog updated feed does not advance past 0")
+	assert.Equal(t, 2, requests)
+}
@@ -38,7 +38,40 @@ func (d *DateTime) UnmarshalJSON(b []byte) error {
 // MarshalJSON implements the json.Marshaler interface.
 // The time is a quoted string in 2006-01-02T15:04:05.000-0700 format
 func (d DateTime) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d)
+	return json.Marshal(time.Time(d).Format("2006-01-02T15:04:05.000-0700"))
+}
+
+// Date represents a date in 2006-01-02 format
+type Date time.Time
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The date is expected to be a quoted string in 2006-01-02 format.
+func (d *Date) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), "\"")
+
+	if s == "null" || s == "" {
+		*d = Date(time.Time{})
+		return nil
+	}
+
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return err
+	}
+	*d = Date(t)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The date is a quoted string in 2006-01-02 format
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Time(d).Format("2006-01-02"))
+}
+
+// NewDate returns the date of the time, to be used in optional fields
+func NewDate(t time.Time) *Date {
+	d := Date(t)
+	return &d
 }
 
 // IssueWrap represents the data returned by the API,
@@ -100,6 +133,34 @@ type IssueField struct {
 	Summary                       string             `json:"summary,omitempty"`
 	Comments                      IssueCommentWrap   `json:"comment,omitempty"`
 	Versions                      []*IssueVersion    `json:"versions,omitempty"`
+	//Values of the custom fields, indexed by field id (e.g. customfield_10002). Fields
+	//without value are not present.
+	Custom map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the fields of the issue, keeping the raw value of the custom fields
+func (f *IssueField) UnmarshalJSON(b []byte) error {
+	type issueField IssueField
+	if err := json.Unmarshal(b, (*issueField)(f)); err != nil {
+		return err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(b, &all); err != nil {
+		return err
+	}
+
+	for id, value := range all {
+		if !strings.HasPrefix(id, "customfield_") || string(value) == "null" {
+			continue
+		}
+		if f.Custom == nil {
+			f.Custom = map[string]json.RawMessage{}
+		}
+		f.Custom[id] = value
+	}
+
+	return nil
 }
 
 // IssueType represents the type of Jira Issue
@@ -111,6 +172,8 @@ type IssueType struct {
 	IconURL     string `json:"iconUrl,omitempty"`
 	SubTask     bool   `json:"subtask,omitempty"`
 	AvatarID    int    `json:"avatarId,omitempty"`
+	//Level of the type in the issue hierarchy, 1 for epics (Jira Cloud only).
+	HierarchyLevel int `json:"hierarchyLevel,omitempty"`
 }
 
 // IssueResolution represents the resolution of Jira Issue
@@ -153,6 +216,7 @@ type IssueTimeTracking struct {
 type IssueUser struct {
 	Key         string            `json:"key,omitempty"`
 	Name        string            `json:"name,omitempty"`
+	AccountID   string            `json:"accountId,omitempty"`
 	SelfLink    string            `json:"self,omitempty"`
 	Email       string            `json:"emailAddress,omitempty"`
 	DisplayName string            `json:"displayName,omitempty"`
@@ -166,6 +230,8 @@ type IssueWatch struct {
 	SelfLink string `json:"self,omitempty"`
 	Count    int    `json:"watchCount,omitempty"`
 	Watching bool   `json:"isWatching,omitempty"`
+	//The watchers of the issue, only returned by IssuesService.ListWatchers.
+	Watchers []*IssueUser `json:"watchers,omitempty"`
 }
 
 // IssuePriority represents the priority of Jira Issue
@@ -193,11 +259,14 @@ type IssueVote struct {
 	SelfLink string `json:"self,omitempty"`
 	Votes    int    `json:"votes,omitempty"`
 	Voted    bool   `json:"hasVoted,omitempty"`
+	//The voters of the issue, only returned by IssuesService.GetVotes.
+	Voters []*IssueUser `json:"voters,omitempty"`
 }
 
 // IssueWorklogWrap represents the worklog list of Jira Issue
 type IssueWorklogWrap struct {
 	Pagination
+	Total    int             `json:"total,omitempty"`
 	Worklogs []*IssueWorklog `json:"worklogs,omitempty"`
 }
 
@@ -214,6 +283,9 @@ type IssueWorklog struct {
 	StartedAt        DateTime   `json:"started,omitempty"`
 	TimeSpent        string     `json:"timeSpent,omitempty"`
 	TimeSpentSeconds int        `json:"timeSpentSeconds,omitempty"`
+	//Restricts the worklog to a role or a group.
+	Visibility *CommentVisibility `json:"visibility,omitempty"`
+	Properties []*Property        `json:"properties,omitempty"`
 }
 
 // IssueStatus represents the status of Jira Issue
@@ -245,6 +317,7 @@ type IssueProgress struct {
 // IssueCommentWrap represents the comments list of Jira Issue
 type IssueCommentWrap struct {
 	Pagination
+	Total    int             `json:"total,omitempty"`
 	Comments []*IssueComment `json:"comments,omitempty"`
 }
 
@@ -257,13 +330,41 @@ type IssueComment struct {
 	UpdateAuthor IssueUser `json:"updateAuthor,omitempty"`
 	CreatedAt    DateTime  `json:"created,omitempty"`
 	UpdatedAt    DateTime  `json:"updated,omitempty"`
+	//The body rendered in HTML, only returned when renderedBody is expanded.
+	RenderedBody string             `json:"renderedBody,omitempty"`
+	Visibility   *CommentVisibility `json:"visibility,omitempty"`
+	//Whether the comment is visible to the customers, Jira Service Desk only.
+	Public     bool        `json:"jsdPublic,omitempty"`
+	Properties []*Property `json:"properties,omitempty"`
+}
+
+// CommentVisibility restricts the visibility of a comment to a role or a group
+type CommentVisibility struct {
+	//role or group.
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
 }
 
 // IssueComponent represents the component of Jira Issue
 type IssueComponent struct {
-	ID       string `json:"id,omitempty"`
-	Name     string `json:"name,omitempty"`
-	SelfLink string `json:"self,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	SelfLink    string `json:"self,omitempty"`
+	Description string `json:"description,omitempty"`
+	//The lead of the component, set with LeadUserName or LeadAccountID.
+	Lead          *IssueUser `json:"lead,omitempty"`
+	LeadUserName  string     `json:"leadUserName,omitempty"`
+	LeadAccountID string     `json:"leadAccountId,omitempty"`
+	//Who is assigned the issues of the component by default, see the ComponentAssignee constants.
+	AssigneeType string     `json:"assigneeType,omitempty"`
+	Assignee     *IssueUser `json:"assignee,omitempty"`
+	//The default assignee actually used, which differs from AssigneeType when it is not valid.
+	RealAssigneeType  string     `json:"realAssigneeType,omitempty"`
+	RealAssignee      *IssueUser `json:"realAssignee,omitempty"`
+	AssigneeTypeValid bool       `json:"isAssigneeTypeValid,omitempty"`
+	//Key of the project of the component.
+	Project   string `json:"project,omitempty"`
+	ProjectID int    `json:"projectId,omitempty"`
 }
 
 // IssueVersion represents the version of Jira Issue
@@ -276,10 +377,12 @@ type IssueVersion struct {
 	Released    bool   `json:"released,omitempty"`
 }
 
-// IssueEstimation represents the estimation of the issue and a fieldId of the field that is used for it
+// IssueEstimation represents the estimation of the issue and a fieldId of the field that is used for it.
+// Value is a number of seconds for time tracking fields, it may have decimals for story points.
+// See Estimate for a typed estimation.
 type IssueEstimation struct {
-	FieldID string `json:"fieldId,omitempty"`
-	Value   int    `json:"value,omitempty"`
+	FieldID string  `json:"fieldId,omitempty"`
+	Value   float64 `json:"value,omitempty"`
 }
 
 // IssueKeys contains the issue key to perform the actions
@@ -434,3 +537,26 @@ func (i *IssuesService) Rank(ctx context.Context, rank *IssueRank) (*IssueRankEn
 
 	return entries, resp, nil
 }
+
+// listAllIssues calls list until the last page of issues is returned, starting at the
+// page defined by opts, and returns the issues of all pages.
+func listAllIssues(ctx context.Context, opts *IssuesOptions, list func(context.Context, *IssuesOptions) ([]*Issue, *Response, error)) ([]*Issue, *Response, error) {
+	o := IssuesOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	var all []*Issue
+	for {
+		issues, resp, err := list(ctx, &o)
+		if err != nil {
+			return nil, resp, err
+		}
+		all = append(all, issues...)
+
+		if resp.IsLast || len(issues) == 0 || len(issues) < resp.MaxResults {
+			return all, resp, nil
+		}
+		o.StartAt += len(issues)
+	}
+}
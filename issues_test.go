@@ -630,7 +630,7 @@ func TestIssuesServiceGetEstimation(t *testing.T) {
 
 	assert.NotNil(t, issueEst)
 	assert.Equal(t, "timeoriginalestimate", issueEst.FieldID)
-	assert.Equal(t, 10800, issueEst.Value)
+	assert.Equal(t, 10800.0, issueEst.Value)
 }
 
 func TestIssuesServiceEstimation(t *testing.T) {
@@ -647,7 +647,7 @@ func TestIssuesServiceEstimation(t *testing.T) {
 
 	assert.NotNil(t, issueEst)
 	assert.Equal(t, "timeoriginalestimate", issueEst.FieldID)
-	assert.Equal(t, 10800, issueEst.Value)
+	assert.Equal(t, 10800.0, issueEst.Value)
 }
 
 func TestIssuesServiceRanking(t *testing.T) {
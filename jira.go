@@ -15,6 +15,11 @@ import (
 	"github.com/fatih/structs"
 )
 
+// apiPath is the path of the Jira platform REST API, relative to the BaseURL of the
+// client, which points to the Jira Agile API (e.g. https://jira.com/rest/agile/1.0/).
+// Platform resources like fields or issue create are only available there.
+const apiPath = "../../api/2/"
+
 // A Client manages communication with the Jira Agile API.
 type Client struct {
 	client  *http.Client
@@ -24,11 +29,15 @@ type Client struct {
 	// Reuse a single struct instead of allocating one for each service on the heap.
 	common service
 
-	Boards  *BoardsService
-	Epics   *EpicsService
-	Issues  *IssuesService
-	Sprints *SprintsService
-	Backlog *BacklogService
+	Boards     *BoardsService
+	Epics      *EpicsService
+	Issues     *IssuesService
+	Sprints    *SprintsService
+	Backlog    *BacklogService
+	Statuses   *StatusesService
+	Versions   *VersionsService
+	Components *ComponentsService
+	Projects   *ProjectsService
 }
 
 type service struct {
@@ -63,6 +72,10 @@ func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
 	c.Issues = (*IssuesService)(&c.common)
 	c.Sprints = (*SprintsService)(&c.common)
 	c.Backlog = (*BacklogService)(&c.common)
+	c.Statuses = (*StatusesService)(&c.common)
+	c.Versions = (*VersionsService)(&c.common)
+	c.Components = (*ComponentsService)(&c.common)
+	c.Projects = (*ProjectsService)(&c.common)
 
 	return c, nil
 }
@@ -110,7 +123,7 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 // interface, the raw response body will be written to v, without attempting to
 // first decode it.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	req.WithContext(ctx)
+	req = req.WithContext(ctx)
 
 	resp, err := c.client.Do(req)
 	if err != nil {
@@ -186,6 +199,13 @@ func (r *ErrorResponse) Error() string {
 		r.Response.StatusCode, r.Messages, r.Errors)
 }
 
+// IsNotFound reports whether the error is an *ErrorResponse with the 404 status code,
+// e.g. an entity property that does not exist.
+func IsNotFound(err error) bool {
+	errResp, ok := err.(*ErrorResponse)
+	return ok && errResp.Response != nil && errResp.Response.StatusCode == http.StatusNotFound
+}
+
 // BasicAuthTransport is an http.RoundTripper that authenticates all requests
 // using HTTP Basic Authentication with the provided username and password.
 type BasicAuthTransport struct {
@@ -196,26 +216,10 @@ type BasicAuthTransport struct {
 
 // RoundTrip implements the RoundTripper interface.
 func (t *BasicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	// To set extra headers, we must make a copy of the Request so
-	// that we don't modify the Request we were given. This is required by the
-	// specification of http.RoundTripper.
-	//
-	// Since we are going to modify only req.Header here, we only need a deep copy
-	// of req.Header.
-	req2 := new(http.Request)
-	*req2 = *req
-	req2.Header = make(http.Header, len(req.Header))
-	for k, s := range req.Header {
-		req2.Header[k] = append([]string(nil), s...)
-	}
-
+	req2 := cloneRequest(req)
 	req2.SetBasicAuth(t.Username, t.Password)
 
-	if t.Transport != nil {
-		return t.Transport.RoundTrip(req2)
-	}
-	return http.DefaultTransport.RoundTrip(req2)
-
+	return transport(t.Transport).RoundTrip(req2)
 }
 
 // Client returns an *http.Client that makes requests that are authenticated
@@ -226,7 +230,7 @@ func (t *BasicAuthTransport) Client() *http.Client {
 
 // QueryParameters returns a query parameters string to use in the request.
 // Some endpoint allow options using query parameters, this method returns a
-// string as expected: ?k1=v1&k2=v2&k3=v3
+// string as expected: ?k1=v1&k2=v2&k3=v3, with URL-escaped values
 func QueryParameters(val interface{}) string {
 	if val == nil || (reflect.ValueOf(val).Kind() == reflect.Ptr && reflect.ValueOf(val).IsNil()) {
 		return ""
@@ -242,7 +246,7 @@ func QueryParameters(val interface{}) string {
 		t := f.Tag("query")
 
 		if !f.IsZero() {
-			query = append(query, fmt.Sprintf("%v=%v", t, v))
+			query = append(query, fmt.Sprintf("%v=%v", t, url.QueryEscape(fmt.Sprint(v))))
 		}
 	}
 
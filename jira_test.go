@@ -30,6 +30,8 @@ func setup() (client *Client, mux *http.ServeMux, serverURL string, teardown fun
 
 	apiHandler := http.NewServeMux()
 	apiHandler.Handle(baseURLPath+"/", http.StripPrefix(baseURLPath, mux))
+	// platform API requests (see apiPath) are handled with their full path, e.g. /api/2/issue
+	apiHandler.Handle("/api/2/", mux)
 	apiHandler.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
 		fmt.Fprintln(os.Stderr, "FAIL: Client.BaseURL path prefix is not preserved in the request URL:")
 		fmt.Fprintln(os.Stderr)
@@ -167,6 +169,25 @@ func TestDoNoContent(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestDoCanceledContext(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	called := false
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	_, err := client.Do(ctx, req, nil)
+
+	assert.Equal(t, context.Canceled, err)
+	assert.False(t, called)
+}
+
 func TestBasicAuthTransport(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -223,6 +244,14 @@ func TestQueryParameters(t *testing.T) {
 			Query:     "?name=foo",
 			Assetions: 1,
 		},
+		{
+			Name: "escaped values",
+			Options: &MyOptions{
+				Name: "R&D tools",
+			},
+			Query:     "?name=R%26D+tools",
+			Assetions: 1,
+		},
 		{
 			Name:      "empty options",
 			Options:   &MyOptions{},
@@ -0,0 +1,398 @@
+package jira
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Authentication methods supported by a Profile
+const (
+	AuthBasic   = "basic"
+	AuthPAT     = "pat"
+	AuthOAuth1  = "oauth1"
+	AuthSession = "session"
+)
+
+// Environment variables used by NewClientFromProfile when a value
+// is not defined in the profile
+const (
+	EnvConfig  = "JIRA_CONFIG"
+	EnvProfile = "JIRA_PROFILE"
+	EnvURL     = "JIRA_URL"
+	EnvUser    = "JIRA_USER"
+	EnvPass    = "JIRA_PASS"
+	EnvToken   = "JIRA_TOKEN"
+)
+
+// agilePath is the path of the Jira Agile API, relative to the site root
+const agilePath = "rest/agile/1.0/"
+
+// ProfileConfig represents a YAML or TOML configuration file with named profiles, e.g.
+//
+//	default: work
+//	profiles:
+//	  work:
+//	    url: https://jira.mycompany.com
+//	    auth: pat
+//	    token: my-token
+//	    timeout: 30s
+//	    rateLimit: 10
+//
+// or, in TOML
+//
+//	default = "work"
+//	[profiles.work]
+//	url = "https://jira.mycompany.com"
+//	auth = "pat"
+//	token = "my-token"
+//	timeout = "30s"
+//	rateLimit = 10
+type ProfileConfig struct {
+	Default  string              `yaml:"default,omitempty" toml:"default,omitempty"`
+	Profiles map[string]*Profile `yaml:"profiles,omitempty" toml:"profiles,omitempty"`
+}
+
+// Profile represents the site and credentials used to build a Client
+type Profile struct {
+	//The URL of the Jira site. The Agile API path is appended when it is not present.
+	URL string `yaml:"url,omitempty" toml:"url,omitempty"`
+	//Valid values: basic, pat, oauth1, session. Default: basic or pat, if a token is defined.
+	Auth string `yaml:"auth,omitempty" toml:"auth,omitempty"`
+	//Used by basic and session authentication.
+	Username string `yaml:"username,omitempty" toml:"username,omitempty"`
+	Password string `yaml:"password,omitempty" toml:"password,omitempty"`
+	//Personal access token used by pat authentication.
+	Token string `yaml:"token,omitempty" toml:"token,omitempty"`
+	//Used by oauth1 authentication.
+	OAuth1 *OAuth1Profile `yaml:"oauth1,omitempty" toml:"oauth1,omitempty"`
+	//Time limit for requests made by the client, e.g. 30s. Default: no timeout.
+	Timeout time.Duration `yaml:"timeout,omitempty" toml:"timeout,omitempty"`
+	//Maximum number of requests per second. Default: no limit.
+	RateLimit float64 `yaml:"rateLimit,omitempty" toml:"rateLimit,omitempty"`
+	//Number of requests that can be sent at once when RateLimit is defined. Default: 1.
+	RateBurst int `yaml:"rateBurst,omitempty" toml:"rateBurst,omitempty"`
+}
+
+// OAuth1Profile contains the OAuth 1.0a options of a Profile
+type OAuth1Profile struct {
+	ConsumerKey string `yaml:"consumerKey,omitempty" toml:"consumerKey,omitempty"`
+	AccessToken string `yaml:"accessToken,omitempty" toml:"accessToken,omitempty"`
+	//PEM encoded RSA private key, PrivateKeyFile is used when it is empty.
+	PrivateKey     string `yaml:"privateKey,omitempty" toml:"privateKey,omitempty"`
+	PrivateKeyFile string `yaml:"privateKeyFile,omitempty" toml:"privateKeyFile,omitempty"`
+}
+
+// DefaultProfilePath returns the path of the profiles configuration file,
+// $JIRA_CONFIG or ~/.config/jira/config.yaml, ~/.config/jira/config.toml
+// when only the latter exists
+func DefaultProfilePath() string {
+	if p := os.Getenv(EnvConfig); p != "" {
+		return p
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	dir := filepath.Join(home, ".config", "jira")
+	if _, err := os.Stat(filepath.Join(dir, "config.yaml")); os.IsNotExist(err) {
+		if _, err := os.Stat(filepath.Join(dir, "config.toml")); err == nil {
+			return filepath.Join(dir, "config.toml")
+		}
+	}
+
+	return filepath.Join(dir, "config.yaml")
+}
+
+// LoadProfiles reads the profiles configuration file from the given path. The file
+// is read as TOML when its extension is .toml, as YAML otherwise.
+func LoadProfiles(path string) (*ProfileConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	unmarshal := yaml.Unmarshal
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		unmarshal = toml.Unmarshal
+	}
+
+	config := &ProfileConfig{}
+	if err := unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("jira: invalid profiles file %s: %v", path, err)
+	}
+
+	return config, nil
+}
+
+// Profile returns the profile with the given name. An empty name
+// returns the default profile.
+func (c *ProfileConfig) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = c.Default
+	}
+
+	p, ok := c.Profiles[name]
+	if !ok || p == nil {
+		return nil, fmt.Errorf("jira: profile %q not found", name)
+	}
+
+	return p, nil
+}
+
+// NewClientFromProfile returns a new Jira Agile API client for the named
+// profile. An empty name uses $JIRA_PROFILE or the default profile of the
+// configuration file (see DefaultProfilePath). Values missing in the profile
+// are read from the environment ($JIRA_URL, $JIRA_USER, $JIRA_PASS and
+// $JIRA_TOKEN) and then from ~/.netrc. When there is no configuration file,
+// or no profile is named and the file has no default, the client is built
+// from the environment and ~/.netrc only.
+func NewClientFromProfile(name string) (*Client, error) {
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+
+	profile := &Profile{}
+
+	path := DefaultProfilePath()
+	config, err := LoadProfiles(path)
+	switch {
+	case err == nil && name == "" && config.Default == "":
+	case err == nil:
+		if profile, err = config.Profile(name); err != nil {
+			return nil, err
+		}
+	case os.IsNotExist(err) && name == "":
+	case os.IsNotExist(err):
+		return nil, fmt.Errorf("jira: profile %q not found, %s does not exist", name, path)
+	default:
+		return nil, err
+	}
+
+	p := *profile
+	p.fillFromEnv()
+	if err := p.fillFromNetrc(netrcPath()); err != nil {
+		return nil, err
+	}
+
+	return p.NewClient()
+}
+
+// NewClient returns a new Jira Agile API client authenticated as
+// defined in the profile.
+func (p *Profile) NewClient() (*Client, error) {
+	if p.URL == "" {
+		return nil, fmt.Errorf("jira: profile URL is required")
+	}
+
+	site, err := siteURL(p.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	var rt http.RoundTripper
+	if p.RateLimit > 0 {
+		rt = &RateLimitTransport{Rate: p.RateLimit, Burst: p.RateBurst}
+	}
+
+	auth := strings.ToLower(p.Auth)
+	if auth == "" {
+		auth = AuthBasic
+		if p.Token != "" {
+			auth = AuthPAT
+		}
+	}
+
+	switch auth {
+	case AuthBasic:
+		rt = &BasicAuthTransport{Transport: rt, Username: p.Username, Password: p.Password}
+	case AuthPAT:
+		rt = &BearerAuthTransport{Transport: rt, Token: p.Token}
+	case AuthSession:
+		rt = &SessionAuthTransport{Transport: rt, SiteURL: site.String(), Username: p.Username, Password: p.Password}
+	case AuthOAuth1:
+		if p.OAuth1 == nil {
+			return nil, fmt.Errorf("jira: oauth1 options are required by oauth1 authentication")
+		}
+
+		pemData := []byte(p.OAuth1.PrivateKey)
+		if len(pemData) == 0 {
+			if pemData, err = ioutil.ReadFile(p.OAuth1.PrivateKeyFile); err != nil {
+				return nil, err
+			}
+		}
+
+		key, err := ParseRSAPrivateKey(pemData)
+		if err != nil {
+			return nil, err
+		}
+
+		rt = &OAuth1Transport{
+			Transport:   rt,
+			ConsumerKey: p.OAuth1.ConsumerKey,
+			AccessToken: p.OAuth1.AccessToken,
+			PrivateKey:  key,
+		}
+	default:
+		return nil, fmt.Errorf("jira: unknown authentication method %q", p.Auth)
+	}
+
+	baseURL := p.URL
+	if !strings.Contains(baseURL, agilePath) {
+		baseURL = site.String() + agilePath
+	}
+
+	return NewClient(baseURL, &http.Client{Transport: rt, Timeout: p.Timeout})
+}
+
+func (p *Profile) fillFromEnv() {
+	if p.URL == "" {
+		p.URL = os.Getenv(EnvURL)
+	}
+	if p.Username == "" {
+		p.Username = os.Getenv(EnvUser)
+	}
+	if p.Password == "" {
+		p.Password = os.Getenv(EnvPass)
+	}
+	if p.Token == "" {
+		p.Token = os.Getenv(EnvToken)
+	}
+}
+
+func (p *Profile) fillFromNetrc(path string) error {
+	if p.URL == "" || (p.Username != "" && p.Password != "") || p.Token != "" {
+		return nil
+	}
+
+	u, err := url.Parse(p.URL)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	login, password, ok := lookupNetrc(f, u.Hostname())
+	if !ok || (p.Username != "" && p.Username != login) {
+		return nil
+	}
+
+	p.Username = login
+	p.Password = password
+
+	return nil
+}
+
+func netrcPath() string {
+	if p := os.Getenv("NETRC"); p != "" {
+		return p
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".netrc")
+}
+
+// lookupNetrc returns the login and password of the given machine, or the
+// default entry, from a netrc file.
+func lookupNetrc(r io.Reader, host string) (string, string, bool) {
+	var tokens []string
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		tokens = append(tokens, strings.Fields(line)...)
+	}
+
+	type entry struct {
+		login, password string
+	}
+	var current, match, def *entry
+
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i] {
+		case "machine":
+			current = nil
+			if i+1 < len(tokens) {
+				i++
+				current = &entry{}
+				if tokens[i] == host && match == nil {
+					match = current
+				}
+			}
+		case "default":
+			current = &entry{}
+			def = current
+		case "login", "password", "account":
+			if i+1 >= len(tokens) {
+				break
+			}
+			i++
+			if current == nil {
+				continue
+			}
+			if tokens[i-1] == "login" {
+				current.login = tokens[i]
+			} else if tokens[i-1] == "password" {
+				current.password = tokens[i]
+			}
+		case "macdef":
+			// macro definitions are not supported and end the parsing
+			i = len(tokens)
+		}
+	}
+
+	if match == nil {
+		match = def
+	}
+	if match == nil {
+		return "", "", false
+	}
+
+	return match.login, match.password, true
+}
+
+// siteURL returns the root of the Jira site from any URL under it,
+// e.g. https://jira.com/rest/agile/1.0/ => https://jira.com/
+func siteURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if i := strings.Index(u.Path, "/rest/"); i >= 0 {
+		u.Path = u.Path[:i]
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	return u, nil
+}
@@ -0,0 +1,266 @@
+package jira
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupProfileEnv(t *testing.T, config, netrc string) func() {
+	dir, err := ioutil.TempDir("", "jira-profile")
+	assert.Nil(t, err)
+
+	vars := map[string]string{
+		EnvConfig:  filepath.Join(dir, "config.yaml"),
+		"NETRC":    filepath.Join(dir, "netrc"),
+		EnvProfile: "",
+		EnvURL:     "",
+		EnvUser:    "",
+		EnvPass:    "",
+		EnvToken:   "",
+	}
+
+	old := map[string]string{}
+	for k, v := range vars {
+		old[k] = os.Getenv(k)
+		os.Setenv(k, v)
+	}
+
+	if config != "" {
+		assert.Nil(t, ioutil.WriteFile(vars[EnvConfig], []byte(config), 0600))
+	}
+	if netrc != "" {
+		assert.Nil(t, ioutil.WriteFile(vars["NETRC"], []byte(netrc), 0600))
+	}
+
+	return func() {
+		for k, v := range old {
+			os.Setenv(k, v)
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+func TestNewClientFromProfile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/1", r.URL.Path)
+		assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	config := `
+default: work
+profiles:
+  work:
+    url: ` + server.URL + `
+    auth: pat
+    token: my-token
+    timeout: 30s
+    rateLimit: 5
+  other:
+    url: https://other.com
+`
+	defer setupProfileEnv(t, config, "")()
+
+	client, err := NewClientFromProfile("")
+	assert.Nil(t, err)
+	assert.Equal(t, server.URL+"/rest/agile/1.0/", client.BaseURL.String())
+	assert.Equal(t, 30*time.Second, client.client.Timeout)
+
+	board, _, err := client.Boards.Get(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, board.ID)
+
+	_, err = NewClientFromProfile("unknown")
+	assert.NotNil(t, err)
+}
+
+func TestNewClientFromProfileEnvAndNetrc(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u, p, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "netrc-user", u)
+		assert.Equal(t, "netrc-pass", p)
+	}))
+	defer server.Close()
+
+	netrc := "machine other.com login x password y\nmachine 127.0.0.1 login netrc-user password netrc-pass\n"
+	defer setupProfileEnv(t, "", netrc)()
+	os.Setenv(EnvURL, server.URL+"/rest/agile/1.0/")
+
+	client, err := NewClientFromProfile("")
+	assert.Nil(t, err)
+	assert.Equal(t, server.URL+"/rest/agile/1.0/", client.BaseURL.String())
+
+	req, _ := client.NewRequest("GET", "board", nil)
+	_, err = client.Do(context.Background(), req, nil)
+	assert.Nil(t, err)
+
+	_, err = NewClientFromProfile("work")
+	assert.NotNil(t, err)
+}
+
+func TestNewClientFromProfileWithoutDefault(t *testing.T) {
+	config := `
+profiles:
+  work:
+    url: https://jira.mycompany.com
+`
+	defer setupProfileEnv(t, config, "")()
+	os.Setenv(EnvURL, "https://env.mycompany.com")
+	os.Setenv(EnvToken, "env-token")
+
+	client, err := NewClientFromProfile("")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://env.mycompany.com/rest/agile/1.0/", client.BaseURL.String())
+
+	client, err = NewClientFromProfile("work")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://jira.mycompany.com/rest/agile/1.0/", client.BaseURL.String())
+
+	_, err = NewClientFromProfile("unknown")
+	assert.EqualError(t, err, `jira: profile "unknown" not found`)
+}
+
+func TestLoadProfiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jira-profile")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"config.yaml": `
+default: work
+profiles:
+  work:
+    url: https://jira.mycompany.com
+    auth: oauth1
+    timeout: 30s
+    rateLimit: 2.5
+    oauth1:
+      consumerKey: my-key
+`,
+		"config.toml": `
+default = "work"
+
+[profiles.work]
+url = "https://jira.mycompany.com"
+auth = "oauth1"
+timeout = "30s"
+rateLimit = 2.5
+
+[profiles.work.oauth1]
+consumerKey = "my-key"
+`,
+	}
+
+	want := &ProfileConfig{
+		Default: "work",
+		Profiles: map[string]*Profile{"work": {
+			URL:       "https://jira.mycompany.com",
+			Auth:      AuthOAuth1,
+			Timeout:   30 * time.Second,
+			RateLimit: 2.5,
+			OAuth1:    &OAuth1Profile{ConsumerKey: "my-key"},
+		}},
+	}
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		assert.Nil(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+		config, err := LoadProfiles(path)
+		assert.Nil(t, err, name)
+		assert.Equal(t, want, config, name)
+	}
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "invalid.toml"), []byte("default: work"), 0600))
+	_, err = LoadProfiles(filepath.Join(dir, "invalid.toml"))
+	assert.NotNil(t, err)
+}
+
+func TestDefaultProfilePath(t *testing.T) {
+	home, err := ioutil.TempDir("", "jira-home")
+	assert.Nil(t, err)
+	defer os.RemoveAll(home)
+
+	defer func(config, home string) {
+		os.Setenv(EnvConfig, config)
+		os.Setenv("HOME", home)
+	}(os.Getenv(EnvConfig), os.Getenv("HOME"))
+	os.Setenv(EnvConfig, "")
+	os.Setenv("HOME", home)
+
+	dir := filepath.Join(home, ".config", "jira")
+	assert.Equal(t, filepath.Join(dir, "config.yaml"), DefaultProfilePath())
+
+	assert.Nil(t, os.MkdirAll(dir, 0700))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "config.toml"), nil, 0600))
+	assert.Equal(t, filepath.Join(dir, "config.toml"), DefaultProfilePath())
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "config.yaml"), nil, 0600))
+	assert.Equal(t, filepath.Join(dir, "config.yaml"), DefaultProfilePath())
+
+	os.Setenv(EnvConfig, "/etc/jira.toml")
+	assert.Equal(t, "/etc/jira.toml", DefaultProfilePath())
+}
+
+func TestProfileNewClientErrors(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Profile *Profile
+	}{
+		{Name: "no url", Profile: &Profile{}},
+		{Name: "unknown auth", Profile: &Profile{URL: "https://jira.com", Auth: "kerberos"}},
+		{Name: "oauth1 without options", Profile: &Profile{URL: "https://jira.com", Auth: AuthOAuth1}},
+		{Name: "oauth1 invalid key", Profile: &Profile{URL: "https://jira.com", Auth: AuthOAuth1, OAuth1: &OAuth1Profile{PrivateKey: "foo"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			_, err := tt.Profile.NewClient()
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestLookupNetrc(t *testing.T) {
+	netrc := `# comment
+machine jira.com
+  login foo
+  password bar
+default login anonymous password guest
+`
+	login, password, ok := lookupNetrc(strings.NewReader(netrc), "jira.com")
+	assert.True(t, ok)
+	assert.Equal(t, "foo", login)
+	assert.Equal(t, "bar", password)
+
+	login, password, ok = lookupNetrc(strings.NewReader(netrc), "other.com")
+	assert.True(t, ok)
+	assert.Equal(t, "anonymous", login)
+	assert.Equal(t, "guest", password)
+
+	_, _, ok = lookupNetrc(strings.NewReader("machine jira.com login foo"), "other.com")
+	assert.False(t, ok)
+}
+
+func TestSiteURL(t *testing.T) {
+	for in, want := range map[string]string{
+		"https://jira.com":                      "https://jira.com/",
+		"https://jira.com/rest/agile/1.0/":      "https://jira.com/",
+		"https://jira.com/jira/rest/agile/1.0/": "https://jira.com/jira/",
+	} {
+		u, err := siteURL(in)
+		assert.Nil(t, err)
+		assert.Equal(t, want, u.String())
+	}
+}
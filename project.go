@@ -22,9 +22,27 @@ type Project struct {
 	Name       string            `json:"name,omitempty"`
 	SelfLink   string            `json:"self,omitempty"`
 	AvatarURLs map[string]string `json:"avatarUrls,omitempty"`
-	Category   ProjectCategory   `json:"projectCategory,omitempty"`
-	Simplified string            `json:"simplified,omitempty"`
-	Style      string            `json:"style,omitempty"`
+	Category   *ProjectCategory  `json:"projectCategory,omitempty"`
+	//Whether the project is team-managed (next-gen), Jira Cloud only.
+	Simplified bool `json:"simplified,omitempty"`
+	//The style of the project, classic or next-gen, Jira Cloud only.
+	Style          string            `json:"style,omitempty"`
+	Description    string            `json:"description,omitempty"`
+	Lead           *IssueUser        `json:"lead,omitempty"`
+	ProjectTypeKey string            `json:"projectTypeKey,omitempty"`
+	AssigneeType   string            `json:"assigneeType,omitempty"`
+	URL            string            `json:"url,omitempty"`
+	Email          string            `json:"email,omitempty"`
+	IssueTypes     []*IssueType      `json:"issueTypes,omitempty"`
+	Components     []*IssueComponent `json:"components,omitempty"`
+	Versions       []*Version        `json:"versions,omitempty"`
+	Roles          map[string]string `json:"roles,omitempty"`
+	Private        bool              `json:"isPrivate,omitempty"`
+}
+
+// TeamManaged reports whether the project is team-managed (next-gen)
+func (p *Project) TeamManaged() bool {
+	return p.Simplified || p.Style == "next-gen"
 }
 
 // ProjectsOptions contains all options to get a project from a board
@@ -0,0 +1,337 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+)
+
+// ProjectsService handles communication with the project related
+// methods of the Jira platform API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/project
+type ProjectsService service
+
+// NewProject contains the fields to create or update a project
+type NewProject struct {
+	Key                string `json:"key,omitempty"`
+	Name               string `json:"name,omitempty"`
+	ProjectTypeKey     string `json:"projectTypeKey,omitempty"`
+	ProjectTemplateKey string `json:"projectTemplateKey,omitempty"`
+	Description        string `json:"description,omitempty"`
+	//The lead of the project, its username (Jira Server) or its account id (Jira Cloud).
+	Lead          string `json:"lead,omitempty"`
+	LeadAccountID string `json:"leadAccountId,omitempty"`
+	URL           string `json:"url,omitempty"`
+	AssigneeType  string `json:"assigneeType,omitempty"`
+	AvatarID      int    `json:"avatarId,omitempty"`
+	CategoryID    int    `json:"categoryId,omitempty"`
+}
+
+// ProjectIssueTypeStatuses contains the statuses of an issue type of a project
+type ProjectIssueTypeStatuses struct {
+	ID       string         `json:"id,omitempty"`
+	Name     string         `json:"name,omitempty"`
+	SelfLink string         `json:"self,omitempty"`
+	SubTask  bool           `json:"subtask,omitempty"`
+	Statuses []*IssueStatus `json:"statuses,omitempty"`
+}
+
+// ProjectRole represents a role of a project with the users and groups that have it
+type ProjectRole struct {
+	ID          int                 `json:"id,omitempty"`
+	Name        string              `json:"name,omitempty"`
+	SelfLink    string              `json:"self,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Actors      []*ProjectRoleActor `json:"actors,omitempty"`
+}
+
+// ProjectRoleActor represents a user or a group that has a project role
+type ProjectRoleActor struct {
+	ID          int    `json:"id,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	//atlassian-user-role-actor for users, atlassian-group-role-actor for groups.
+	Type      string `json:"type,omitempty"`
+	Name      string `json:"name,omitempty"`
+	AvatarURL string `json:"avatarUrl,omitempty"`
+	//The user of the actor, only returned by Jira Cloud.
+	User *IssueUser `json:"actorUser,omitempty"`
+}
+
+// ListProjectsOptions contains all options to list all projects
+type ListProjectsOptions struct {
+	//Use expand to include additional information in the response, e.g. description, lead, issueTypes, url, projectKeys.
+	Expand string `query:"expand"`
+	//If this parameter is set then only projects recently accessed by the current user (if not logged in then based on HTTP session) will be returned (maximum count limited to the specified number but no more than 20).
+	Recent int `query:"recent"`
+}
+
+// SearchProjectsOptions contains all options to search projects
+type SearchProjectsOptions struct {
+	//The starting index of the returned projects. Base index: 0.
+	StartAt int `query:"startAt"`
+	//The maximum number of projects to return per page. Default: 50.
+	MaxResults int `query:"maxResults"`
+	//Filter the results using a literal string. Projects with a matching key or name are returned (case insensitive).
+	Query string `query:"query"`
+	//Order the results by a field, e.g. key, name, category, -lastIssueUpdatedTime.
+	OrderBy string `query:"orderBy"`
+	//Orders results by the project type. Valid values: business, service_desk, software.
+	TypeKey string `query:"typeKey"`
+	//The ID of the project's category.
+	CategoryID int `query:"categoryId"`
+	//Use expand to include additional information in the response, e.g. description, lead, issueTypes, url.
+	Expand string `query:"expand"`
+}
+
+// GetProjectOptions contains the options to get a project
+type GetProjectOptions struct {
+	//Use expand to include additional information in the response, e.g. description, lead, issueTypes, url, projectKeys.
+	Expand string `query:"expand"`
+}
+
+// List returns all projects visible to the user.
+//
+// GET /rest/api/2/project
+func (p *ProjectsService) List(ctx context.Context, opts *ListProjectsOptions) ([]*Project, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := p.client.NewRequest("GET", apiPath+"project"+q, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var projects []*Project
+	resp, err := p.client.Do(ctx, req, &projects)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return projects, resp, nil
+}
+
+// Search returns a page of the projects visible to the user, filtered by the options.
+// Only available on Jira Cloud and Jira Server 8 or later.
+//
+// GET /rest/api/2/project/search
+func (p *ProjectsService) Search(ctx context.Context, opts *SearchProjectsOptions) ([]*Project, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := p.client.NewRequest("GET", apiPath+"project/search"+q, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &ProjectWrap{}
+	resp, err := p.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.MaxResults = wrap.MaxResults
+	resp.StartAt = wrap.StartAt
+	resp.IsLast = wrap.IsLast
+
+	return wrap.Values, resp, nil
+}
+
+// Get returns the project for the given project id or key, with its issue types and lead.
+//
+// GET /rest/api/2/project/{projectIdOrKey}
+func (p *ProjectsService) Get(ctx context.Context, idOrKey string, opts *GetProjectOptions) (*Project, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := p.client.NewRequest("GET", fmt.Sprintf("%sproject/%s%s", apiPath, idOrKey, q), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var project = &Project{}
+	resp, err := p.client.Do(ctx, req, project)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return project, resp, nil
+}
+
+// Create creates a project. Only the id, the key and the self link of the project are
+// returned.
+//
+// POST /rest/api/2/project
+func (p *ProjectsService) Create(ctx context.Context, newProject *NewProject) (*Project, *Response, error) {
+
+	req, err := p.client.NewRequest("POST", apiPath+"project", newProject)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the id of the created project is a number
+	var created struct {
+		ID       json.Number `json:"id"`
+		Key      string      `json:"key"`
+		SelfLink string      `json:"self"`
+	}
+	resp, err := p.client.Do(ctx, req, &created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &Project{ID: created.ID.String(), Key: created.Key, SelfLink: created.SelfLink}, resp, nil
+}
+
+// Update updates a project, only the fields present in the request are updated.
+//
+// PUT /rest/api/2/project/{projectIdOrKey}
+func (p *ProjectsService) Update(ctx context.Context, idOrKey string, project *NewProject) (*Project, *Response, error) {
+
+	req, err := p.client.NewRequest("PUT", fmt.Sprintf("%sproject/%s", apiPath, idOrKey), project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated = &Project{}
+	resp, err := p.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, nil
+}
+
+// Delete deletes a project and all of its issues.
+//
+// DELETE /rest/api/2/project/{projectIdOrKey}
+func (p *ProjectsService) Delete(ctx context.Context, idOrKey string) (bool, *Response, error) {
+
+	req, err := p.client.NewRequest("DELETE", fmt.Sprintf("%sproject/%s", apiPath, idOrKey), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := p.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// ListStatuses returns the issue types of the project with their statuses.
+//
+// GET /rest/api/2/project/{projectIdOrKey}/statuses
+func (p *ProjectsService) ListStatuses(ctx context.Context, idOrKey string) ([]*ProjectIssueTypeStatuses, *Response, error) {
+
+	req, err := p.client.NewRequest("GET", fmt.Sprintf("%sproject/%s/statuses", apiPath, idOrKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statuses []*ProjectIssueTypeStatuses
+	resp, err := p.client.Do(ctx, req, &statuses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return statuses, resp, nil
+}
+
+// ListRoles returns the roles of the project, ordered by name, without their actors
+// (see GetRole).
+//
+// GET /rest/api/2/project/{projectIdOrKey}/role
+func (p *ProjectsService) ListRoles(ctx context.Context, idOrKey string) ([]*ProjectRole, *Response, error) {
+
+	req, err := p.client.NewRequest("GET", fmt.Sprintf("%sproject/%s/role", apiPath, idOrKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var links map[string]string
+	resp, err := p.client.Do(ctx, req, &links)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	roles := make([]*ProjectRole, 0, len(links))
+	for name, link := range links {
+		// the link ends with the role id, e.g. .../project/MKY/role/10360
+		id, err := strconv.Atoi(path.Base(link))
+		if err != nil {
+			return nil, resp, fmt.Errorf("jira: invalid link %q of project role %s", link, name)
+		}
+		roles = append(roles, &ProjectRole{ID: id, Name: name, SelfLink: link})
+	}
+
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+
+	return roles, resp, nil
+}
+
+// GetRole returns a role of the project with its actors, the users and groups that have it.
+//
+// GET /rest/api/2/project/{projectIdOrKey}/role/{id}
+func (p *ProjectsService) GetRole(ctx context.Context, idOrKey string, roleID int) (*ProjectRole, *Response, error) {
+
+	req, err := p.client.NewRequest("GET", fmt.Sprintf("%sproject/%s/role/%d", apiPath, idOrKey, roleID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var role = &ProjectRole{}
+	resp, err := p.client.Do(ctx, req, role)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return role, resp, nil
+}
+
+// ListCategories returns all project categories.
+//
+// GET /rest/api/2/projectCategory
+func (p *ProjectsService) ListCategories(ctx context.Context) ([]*ProjectCategory, *Response, error) {
+
+	req, err := p.client.NewRequest("GET", apiPath+"projectCategory", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var categories []*ProjectCategory
+	resp, err := p.client.Do(ctx, req, &categories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return categories, resp, nil
+}
+
+// GetCategory returns the project category for the given id.
+//
+// GET /rest/api/2/projectCategory/{id}
+func (p *ProjectsService) GetCategory(ctx context.Context, categoryID int) (*ProjectCategory, *Response, error) {
+
+	req, err := p.client.NewRequest("GET", fmt.Sprintf("%sprojectCategory/%d", apiPath, categoryID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var category = &ProjectCategory{}
+	resp, err := p.client.Do(ctx, req, category)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return category, resp, nil
+}
@@ -0,0 +1,165 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectsServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project/NG", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "description,lead,issueTypes", r.URL.Query().Get("expand"))
+		fmt.Fprint(w, `{"self": "https://your-domain.atlassian.net/rest/api/2/project/10100","id": "10100","key": "NG","name": "Next Gen",
+			"description": "A team-managed project","lead": {"accountId": "5b10a2844c20165700ede21g","displayName": "Mia Krystof"},
+			"issueTypes": [{"id": "10201","name": "Epic","hierarchyLevel": 1},{"id": "10202","name": "Sub-task","subtask": true,"hierarchyLevel": -1}],
+			"projectCategory": {"id": "10000","name": "FIRST","description": "First Project Category"},
+			"projectTypeKey": "software","simplified": true,"style": "next-gen","isPrivate": false,
+			"roles": {"Administrators": "https://your-domain.atlassian.net/rest/api/2/project/10100/role/10002"}}`)
+	})
+
+	project, _, err := client.Projects.Get(context.Background(), "NG", &GetProjectOptions{Expand: "description,lead,issueTypes"})
+	assert.Nil(t, err)
+	assert.Equal(t, "NG", project.Key)
+	assert.True(t, project.Simplified)
+	assert.True(t, project.TeamManaged())
+	assert.Equal(t, &ProjectCategory{ID: "10000", Name: "FIRST", Description: "First Project Category"}, project.Category)
+	assert.Equal(t, "5b10a2844c20165700ede21g", project.Lead.AccountID)
+	assert.Len(t, project.IssueTypes, 2)
+	assert.Equal(t, 1, project.IssueTypes[0].HierarchyLevel)
+	assert.True(t, project.IssueTypes[1].SubTask)
+}
+
+func TestProjectsServiceListAndSearch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "5", r.URL.Query().Get("recent"))
+		fmt.Fprint(w, `[{"id": "10000","key": "EX","name": "Example"},{"id": "10001","key": "ABC","name": "Alphabetical"}]`)
+	})
+
+	mux.HandleFunc("/api/2/project/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "R&D tools", r.URL.Query().Get("query"))
+		assert.Equal(t, "software", r.URL.Query().Get("typeKey"))
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 50,"total": 1,"isLast": true,"values": [{"id": "10001","key": "ABC","name": "Alphabetical"}]}`)
+	})
+
+	projects, _, err := client.Projects.List(context.Background(), &ListProjectsOptions{Recent: 5})
+	assert.Nil(t, err)
+	assert.Len(t, projects, 2)
+	assert.Nil(t, projects[0].Category)
+
+	projects, resp, err := client.Projects.Search(context.Background(), &SearchProjectsOptions{Query: "R&D tools", TypeKey: "software"})
+	assert.Nil(t, err)
+	assert.True(t, resp.IsLast)
+	assert.Equal(t, "ABC", projects[0].Key)
+}
+
+func TestProjectsServiceCreateUpdateDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		newProject := &NewProject{}
+		json.NewDecoder(r.Body).Decode(newProject)
+		assert.Equal(t, "EX", newProject.Key)
+		assert.Equal(t, "software", newProject.ProjectTypeKey)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self": "http://example/jira/rest/api/2/project/10042","id": 10042,"key": "EX"}`)
+	})
+
+	mux.HandleFunc("/api/2/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "PUT":
+			body := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, map[string]interface{}{"name": "Example"}, body)
+			fmt.Fprint(w, `{"id": "10042","key": "EX","name": "Example"}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	newProject := &NewProject{Key: "EX", Name: "Ex", ProjectTypeKey: "software", Lead: "fred"}
+	created, _, err := client.Projects.Create(context.Background(), newProject)
+	assert.Nil(t, err)
+	assert.Equal(t, &Project{ID: "10042", Key: "EX", SelfLink: "http://example/jira/rest/api/2/project/10042"}, created)
+
+	project, _, err := client.Projects.Update(context.Background(), "EX", &NewProject{Name: "Example"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Example", project.Name)
+
+	deleted, _, err := client.Projects.Delete(context.Background(), "EX")
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
+
+func TestProjectsServiceStatusesAndRoles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project/EX/statuses", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "3","name": "Task","subtask": false,"statuses": [{"id": "10000","name": "In Progress","statusCategory": {"key": "indeterminate"}}]}]`)
+	})
+
+	mux.HandleFunc("/api/2/project/EX/role", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"Users": "http://www.example.com/jira/rest/api/2/project/EX/role/10001",
+			"Administrators": "http://www.example.com/jira/rest/api/2/project/EX/role/10002"}`)
+	})
+
+	mux.HandleFunc("/api/2/project/EX/role/10002", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"self": "http://www.example.com/jira/rest/api/2/project/EX/role/10002","name": "Administrators","id": 10002,
+			"description": "A project role that represents administrators",
+			"actors": [{"id": 10240,"displayName": "jira-developers","type": "atlassian-group-role-actor","name": "jira-developers"},
+				{"id": 10241,"displayName": "Mia","type": "atlassian-user-role-actor","name": "mia","actorUser": {"accountId": "5b10a2844c20165700ede21g"}}]}`)
+	})
+
+	statuses, _, err := client.Projects.ListStatuses(context.Background(), "EX")
+	assert.Nil(t, err)
+	assert.Equal(t, "Task", statuses[0].Name)
+	assert.Equal(t, StatusCategoryInProgress, statuses[0].Statuses[0].Category.Key)
+
+	roles, _, err := client.Projects.ListRoles(context.Background(), "EX")
+	assert.Nil(t, err)
+	assert.Equal(t, []*ProjectRole{
+		{ID: 10002, Name: "Administrators", SelfLink: "http://www.example.com/jira/rest/api/2/project/EX/role/10002"},
+		{ID: 10001, Name: "Users", SelfLink: "http://www.example.com/jira/rest/api/2/project/EX/role/10001"},
+	}, roles)
+
+	role, _, err := client.Projects.GetRole(context.Background(), "EX", roles[0].ID)
+	assert.Nil(t, err)
+	assert.Len(t, role.Actors, 2)
+	assert.Equal(t, "5b10a2844c20165700ede21g", role.Actors[1].User.AccountID)
+}
+
+func TestProjectsServiceCategories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/projectCategory", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "10000","name": "FIRST","description": "First Project Category"}]`)
+	})
+
+	mux.HandleFunc("/api/2/projectCategory/10000", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "10000","name": "FIRST","description": "First Project Category"}`)
+	})
+
+	categories, _, err := client.Projects.ListCategories(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, categories, 1)
+
+	category, _, err := client.Projects.GetCategory(context.Background(), 10000)
+	assert.Nil(t, err)
+	assert.Equal(t, "FIRST", category.Name)
+}
@@ -0,0 +1,123 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// PropertyKeys represents the list of entity property keys returned by the API
+type PropertyKeys struct {
+	Keys []*PropertyKey `json:"keys,omitempty"`
+}
+
+// PropertyKey represents the key of an entity property
+type PropertyKey struct {
+	Key      string `json:"key,omitempty"`
+	SelfLink string `json:"self,omitempty"`
+}
+
+// Property represents an entity property, its value is any valid JSON
+type Property struct {
+	Key   string          `json:"key,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Unmarshal decodes the property value into the value pointed to by v.
+func (p *Property) Unmarshal(v interface{}) error {
+	return json.Unmarshal(p.Value, v)
+}
+
+// ErrPropertyConflict is returned when a property keeps being changed by someone else
+// while it is updated with a read-modify-write operation.
+var ErrPropertyConflict = errors.New("jira: property was modified concurrently")
+
+// defaultPropertyUpdateAttempts is the number of read-modify-write attempts made when
+// the options do not set it
+const defaultPropertyUpdateAttempts = 5
+
+// UpdatePropertyOptions contains the options of a read-modify-write of a property
+type UpdatePropertyOptions struct {
+	//The number of times the read-modify-write is attempted before ErrPropertyConflict is returned. Default: 5.
+	MaxAttempts int
+}
+
+// updateProperty performs an optimistic read-modify-write of a property. The current value
+// is decoded into v and modified by update. Right before the write, the property is read
+// again, when it no longer matches what was read the whole cycle is restarted. After the
+// write the property is read once more, when it no longer holds the written value someone
+// else wrote in between and the cycle is restarted on top of their value. A write that
+// lands after those checks is not detected, updates can still be lost.
+func updateProperty(ctx context.Context, get func(ctx context.Context) (*Property, *Response, error),
+	set func(ctx context.Context, value interface{}) (bool, *Response, error), v interface{}, update func() error,
+	opts *UpdatePropertyOptions) (*Response, error) {
+
+	attempts := defaultPropertyUpdateAttempts
+	if opts != nil && opts.MaxAttempts > 0 {
+		attempts = opts.MaxAttempts
+	}
+
+	for attempt := 0; attempt < attempts; attempt++ {
+		original, resp, err := get(ctx)
+		if err != nil && !IsNotFound(err) {
+			return resp, err
+		}
+		// a retry must not see the changes made by the previous attempt
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && !rv.IsNil() {
+			rv.Elem().Set(reflect.Zero(rv.Elem().Type()))
+		}
+		if original != nil {
+			if err := original.Unmarshal(v); err != nil {
+				return resp, err
+			}
+		}
+
+		if err := update(); err != nil {
+			return resp, err
+		}
+
+		written, err := json.Marshal(v)
+		if err != nil {
+			return resp, err
+		}
+
+		current, resp, err := get(ctx)
+		if err != nil && !IsNotFound(err) {
+			return resp, err
+		}
+		if !sameProperty(original, current) {
+			continue
+		}
+
+		if _, resp, err = set(ctx, v); err != nil {
+			return resp, err
+		}
+
+		current, resp, err = get(ctx)
+		if err != nil && !IsNotFound(err) {
+			return resp, err
+		}
+		if sameProperty(&Property{Value: written}, current) {
+			return resp, nil
+		}
+	}
+
+	return nil, ErrPropertyConflict
+}
+
+// sameProperty reports whether both properties hold the same JSON value,
+// regardless of formatting. A nil property is a property that does not exist.
+func sameProperty(a, b *Property) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	var va, vb interface{}
+	if json.Unmarshal(a.Value, &va) != nil || json.Unmarshal(b.Value, &vb) != nil {
+		return bytes.Equal(a.Value, b.Value)
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
@@ -0,0 +1,246 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RankPlan contains the rank operations needed to reach a target order. Each move
+// ranks a run of consecutive issues (or epics) of the target order before or after
+// an issue that keeps its position. A plan can be previewed before it is applied.
+type RankPlan struct {
+	Moves []*IssueRank
+}
+
+func (p *RankPlan) String() string {
+	if len(p.Moves) == 0 {
+		return "already in order"
+	}
+
+	lines := make([]string, 0, len(p.Moves))
+	for _, m := range p.Moves {
+		if m.RankAfter != "" {
+			lines = append(lines, fmt.Sprintf("rank %s after %s", strings.Join(m.Issues, ", "), m.RankAfter))
+		} else {
+			lines = append(lines, fmt.Sprintf("rank %s before %s", strings.Join(m.Issues, ", "), m.RankBefore))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// planRanks computes the rank operations that turn the current order into the target
+// order. The keys of the target order that form the longest subsequence already in
+// order stay where they are, which minimizes the number of issues to move. The other
+// keys are grouped in runs of consecutive keys of the target order, one move per run.
+// Keys that are not part of the target order are not moved.
+func planRanks(current, target []string) (*RankPlan, error) {
+	position := make(map[string]int, len(current))
+	for i, key := range current {
+		position[key] = i
+	}
+
+	seen := make(map[string]bool, len(target))
+	var indexes []int // indexes in the target of the keys present in the current order
+	for i, key := range target {
+		if seen[key] {
+			return nil, fmt.Errorf("jira: %s is duplicated in the target order", key)
+		}
+		seen[key] = true
+
+		if _, ok := position[key]; ok {
+			indexes = append(indexes, i)
+		}
+	}
+
+	stable := make(map[string]bool, len(target))
+	for _, i := range longestIncreasing(indexes, func(i int) int { return position[target[i]] }) {
+		stable[target[i]] = true
+	}
+	if len(stable) == 0 && len(target) > 0 {
+		stable[target[0]] = true
+	}
+
+	plan := &RankPlan{}
+	for a := 0; a < len(target); a++ {
+		if stable[target[a]] {
+			continue
+		}
+
+		b := a
+		for b+1 < len(target) && !stable[target[b+1]] {
+			b++
+		}
+
+		move := &IssueRank{Issues: append([]string(nil), target[a:b+1]...)}
+		if a > 0 {
+			move.RankAfter = target[a-1]
+		} else {
+			move.RankBefore = target[b+1]
+		}
+		plan.Moves = append(plan.Moves, move)
+
+		a = b
+	}
+
+	return plan, nil
+}
+
+// longestIncreasing returns the longest subsequence of items whose values are increasing
+func longestIncreasing(items []int, value func(int) int) []int {
+	var tails []int // index in items of the last element of the best subsequence of each length
+	prev := make([]int, len(items))
+
+	for i, item := range items {
+		v := value(item)
+		n := sort.Search(len(tails), func(j int) bool { return value(items[tails[j]]) >= v })
+
+		prev[i] = -1
+		if n > 0 {
+			prev[i] = tails[n-1]
+		}
+
+		if n == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[n] = i
+		}
+	}
+
+	result := make([]int, len(tails))
+	for i, k := len(tails)-1, -1; i >= 0; i-- {
+		if k == -1 {
+			k = tails[len(tails)-1]
+		} else {
+			k = prev[k]
+		}
+		result[i] = items[k]
+	}
+
+	return result
+}
+
+// PlanBacklogOrder computes the rank operations needed to order the board's backlog as
+// the given list of issue keys, without changing anything. The current order is read
+// from the backlog of the board, which is ordered by rank. The plan can be previewed
+// and then executed with ApplyRankPlan.
+//
+// GET /rest/agile/1.0/board/{boardId}/backlog
+func (i *IssuesService) PlanBacklogOrder(ctx context.Context, boardID int, order []string) (*RankPlan, *Response, error) {
+
+	list := func(ctx context.Context, opts *IssuesOptions) ([]*Issue, *Response, error) {
+		return i.client.Boards.ListBacklogIssues(ctx, boardID, opts)
+	}
+
+	issues, resp, err := listAllIssues(ctx, &IssuesOptions{Fields: "summary"}, list)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	current := make([]string, len(issues))
+	for n, issue := range issues {
+		current[n] = issue.Key
+	}
+
+	plan, err := planRanks(current, order)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return plan, resp, nil
+}
+
+// ApplyRankPlan executes the moves of the plan, see BulkRank.
+//
+// PUT /rest/agile/1.0/issue/rank
+func (i *IssuesService) ApplyRankPlan(ctx context.Context, plan *RankPlan) (*BulkResult, *Response, error) {
+	result := &BulkResult{}
+	var resp *Response
+
+	for _, move := range plan.Moves {
+		r, rp, err := i.BulkRank(ctx, move)
+		if r != nil {
+			result.Entries = append(result.Entries, r.Entries...)
+		}
+		if rp != nil {
+			resp = rp
+		}
+		if err != nil {
+			return result, resp, err
+		}
+	}
+
+	return result, resp, nil
+}
+
+// PlanOrder computes the rank operations needed to order the board's epics as the given
+// list of epic keys, without changing anything. The current order is read from the epics
+// of the board. The plan can be previewed and then executed with ApplyRankPlan.
+//
+// GET /rest/agile/1.0/board/{boardId}/epic
+func (e *EpicsService) PlanOrder(ctx context.Context, boardID int, order []string) (*RankPlan, *Response, error) {
+	var current []string
+	var resp *Response
+
+	opts := &EpicsOptions{}
+	for {
+		var epics []*Epic
+		var err error
+		epics, resp, err = e.client.Boards.ListEpics(ctx, boardID, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, epic := range epics {
+			current = append(current, epic.Key)
+		}
+
+		if resp.IsLast || len(epics) == 0 {
+			break
+		}
+		opts.StartAt += len(epics)
+	}
+
+	plan, err := planRanks(current, order)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return plan, resp, nil
+}
+
+// ApplyRankPlan executes the moves of the plan. Epics are ranked one at a time, the
+// first epic of a move is ranked as planned and every next one after the previous one.
+// Failures reported by the API are recorded per epic in the result, other errors stop
+// the operation.
+//
+// PUT /rest/agile/1.0/epic/{epicIdOrKey}/rank
+func (e *EpicsService) ApplyRankPlan(ctx context.Context, plan *RankPlan) (*BulkResult, *Response, error) {
+	result := &BulkResult{}
+	var resp *Response
+
+	for _, move := range plan.Moves {
+		rank := &EpicRank{
+			RankAfter:         move.RankAfter,
+			RankBefore:        move.RankBefore,
+			RankCustomFieldID: move.RankCustomFieldID,
+		}
+
+		for _, key := range move.Issues {
+			var err error
+			_, resp, err = e.Rank(ctx, key, rank)
+			if _, ok := err.(*ErrorResponse); err != nil && !ok {
+				return result, resp, err
+			}
+			result.add([]string{key}, resp, err)
+
+			if err == nil {
+				rank = &EpicRank{RankAfter: key, RankCustomFieldID: move.RankCustomFieldID}
+			}
+		}
+	}
+
+	return result, resp, nil
+}
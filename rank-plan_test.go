@@ -0,0 +1,156 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// applyPlan simulates the moves of a plan on the current order
+func applyPlan(current []string, plan *RankPlan) []string {
+	order := append([]string(nil), current...)
+	for _, m := range plan.Moves {
+		moving := map[string]bool{}
+		for _, k := range m.Issues {
+			moving[k] = true
+		}
+
+		var rest []string
+		for _, k := range order {
+			if !moving[k] {
+				rest = append(rest, k)
+			}
+		}
+
+		anchor := m.RankAfter
+		if anchor == "" {
+			anchor = m.RankBefore
+		}
+		for i, k := range rest {
+			if k == anchor {
+				if m.RankAfter != "" {
+					i++
+				}
+				order = append(append(append([]string(nil), rest[:i]...), m.Issues...), rest[i:]...)
+				break
+			}
+		}
+	}
+	return order
+}
+
+func TestPlanRanks(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Current string
+		Target  string
+		Moves   int
+		Want    string
+	}{
+		{Name: "in order", Current: "A B C D", Target: "A B C D", Moves: 0, Want: "A B C D"},
+		{Name: "subset in order", Current: "A B C D", Target: "A C", Moves: 0, Want: "A B C D"},
+		{Name: "move to top", Current: "A B C D", Target: "D A B C", Moves: 1, Want: "D A B C"},
+		{Name: "move to bottom", Current: "A B C D", Target: "B C D A", Moves: 1, Want: "B C D A"},
+		{Name: "swap runs", Current: "A B C D E F", Target: "D E F A B C", Moves: 1, Want: "D E F A B C"},
+		{Name: "reverse", Current: "A B C D", Target: "D C B A", Moves: 1, Want: "D C B A"},
+		{Name: "interleave", Current: "A B C D E F", Target: "A D B E C F", Moves: 2, Want: "A D B E C F"},
+		{Name: "unknown keys", Current: "A B", Target: "X A Y B", Moves: 2, Want: "X A Y B"},
+		{Name: "only unknown keys", Current: "A B", Target: "X Y", Moves: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			current := strings.Fields(tt.Current)
+			plan, err := planRanks(current, strings.Fields(tt.Target))
+			assert.Nil(t, err)
+			assert.Len(t, plan.Moves, tt.Moves)
+
+			if tt.Want != "" {
+				assert.Equal(t, tt.Want, strings.Join(applyPlan(current, plan), " "))
+			}
+		})
+	}
+
+	_, err := planRanks([]string{"A"}, []string{"A", "B", "A"})
+	assert.NotNil(t, err)
+}
+
+func TestRankPlanString(t *testing.T) {
+	plan, _ := planRanks([]string{"A", "B", "C", "D"}, []string{"C", "A", "B", "D"})
+	assert.Equal(t, "rank C before A", plan.String())
+
+	plan, _ = planRanks([]string{"A", "B", "C", "D"}, []string{"A", "D", "B", "C"})
+	assert.Equal(t, "rank D after A", plan.String())
+
+	assert.Equal(t, "already in order", (&RankPlan{}).String())
+}
+
+func TestIssuesServicePlanAndApplyBacklogOrder(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/84/backlog", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		if r.URL.Query().Get("startAt") == "" {
+			fmt.Fprint(w, `{"startAt": 0,"maxResults": 2,"issues": [{"key": "MCP-1"},{"key": "MCP-2"}]}`)
+			return
+		}
+		assert.Equal(t, "2", r.URL.Query().Get("startAt"))
+		fmt.Fprint(w, `{"startAt": 2,"maxResults": 2,"issues": [{"key": "MCP-3"}]}`)
+	})
+
+	var ranks []*IssueRank
+	mux.HandleFunc("/issue/rank", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		rank := &IssueRank{}
+		json.NewDecoder(r.Body).Decode(rank)
+		ranks = append(ranks, rank)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	plan, _, err := client.Issues.PlanBacklogOrder(context.Background(), 84, []string{"MCP-3", "MCP-1", "MCP-2"})
+	assert.Nil(t, err)
+	assert.Equal(t, "rank MCP-3 before MCP-1", plan.String())
+	assert.Len(t, ranks, 0)
+
+	result, _, err := client.Issues.ApplyRankPlan(context.Background(), plan)
+	assert.Nil(t, err)
+	assert.True(t, result.OK())
+	assert.Len(t, ranks, 1)
+	assert.Equal(t, []string{"MCP-3"}, ranks[0].Issues)
+	assert.Equal(t, "MCP-1", ranks[0].RankBefore)
+}
+
+func TestEpicsServicePlanAndApplyOrder(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/84/epic", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"isLast": true,"values": [{"key": "MCP-10"},{"key": "MCP-11"},{"key": "MCP-12"}]}`)
+	})
+
+	var ranked []string
+	for _, key := range []string{"MCP-10", "MCP-11", "MCP-12"} {
+		key := key
+		mux.HandleFunc("/epic/"+key+"/rank", func(w http.ResponseWriter, r *http.Request) {
+			rank := &EpicRank{}
+			json.NewDecoder(r.Body).Decode(rank)
+			ranked = append(ranked, fmt.Sprintf("%s>%s<%s", key, rank.RankAfter, rank.RankBefore))
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	plan, _, err := client.Epics.PlanOrder(context.Background(), 84, []string{"MCP-12", "MCP-11", "MCP-10"})
+	assert.Nil(t, err)
+	assert.Len(t, plan.Moves, 1)
+
+	result, _, err := client.Epics.ApplyRankPlan(context.Background(), plan)
+	assert.Nil(t, err)
+	assert.True(t, result.OK())
+	assert.Equal(t, []string{"MCP-12><MCP-10", "MCP-11>MCP-12<"}, ranked)
+}
@@ -0,0 +1,198 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// SprintCadence describes a series of sprints of the same length created one after the other
+type SprintCadence struct {
+	//Board on which the sprints are created.
+	BoardID int
+	//A text/template for the name of the sprints, e.g. "Team {{.N}} {{.Start | date}}".
+	//The data has the fields N (the number of the sprint), Start and End, the date
+	//function formats a time using DateFormat.
+	NameTemplate string
+	//Layout used by the date function of the template. Default: 2006-01-02.
+	DateFormat string
+	//Number of the first sprint. Default: 1.
+	FirstNumber int
+	//Start of the first sprint.
+	Start time.Time
+	//Length of each sprint, e.g. 14 * 24 * time.Hour. Whole days are counted as calendar days,
+	//so the sprints keep the time of day of Start across daylight saving time changes.
+	Length time.Duration
+	//Number of sprints to create.
+	Count int
+	//Days of the week on which sprints neither start nor end. Default: Saturday and Sunday.
+	NonWorkingDays []time.Weekday
+	//Dates on which sprints neither start nor end, e.g. public holidays.
+	Holidays []time.Time
+}
+
+// CadenceResult contains the sprints of a cadence that were created and the ones that
+// were skipped because a sprint with the same name already exists on the board
+type CadenceResult struct {
+	Created []*Sprint
+	Skipped []*NewSprint
+}
+
+type cadenceData struct {
+	N     int
+	Start time.Time
+	End   time.Time
+}
+
+// Plan returns the sprints of the cadence without creating them. Every sprint starts
+// when the previous one was planned to end. A start date on a non-working day is moved
+// forward to the next working day and an end date on a non-working day is moved back
+// to the previous working day, so the cadence itself is kept.
+func (c *SprintCadence) Plan() ([]*NewSprint, error) {
+	if c.NameTemplate == "" {
+		return nil, errors.New("jira: the cadence name template is required")
+	}
+	if c.Length <= 0 {
+		return nil, errors.New("jira: the cadence sprint length must be positive")
+	}
+	if c.Start.IsZero() {
+		return nil, errors.New("jira: the cadence start date is required")
+	}
+
+	dateFormat := c.DateFormat
+	if dateFormat == "" {
+		dateFormat = "2006-01-02"
+	}
+
+	funcs := template.FuncMap{
+		"date": func(t time.Time) string { return t.Format(dateFormat) },
+	}
+	tmpl, err := template.New("name").Funcs(funcs).Parse(c.NameTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	first := c.FirstNumber
+	if first == 0 {
+		first = 1
+	}
+
+	sprints := make([]*NewSprint, 0, c.Count)
+	for i := 0; i < c.Count; i++ {
+		nominal := c.after(i)
+		start := c.shift(nominal, 1)
+		end := c.shift(c.after(i+1), -1)
+		if !end.After(start) {
+			return nil, errors.New("jira: the cadence sprint length is shorter than the non-working days")
+		}
+
+		var name bytes.Buffer
+		if err := tmpl.Execute(&name, cadenceData{N: first + i, Start: start, End: end}); err != nil {
+			return nil, err
+		}
+
+		sprints = append(sprints, &NewSprint{
+			Name:    strings.TrimSpace(name.String()),
+			BoardID: c.BoardID,
+			Start:   &start,
+			End:     &end,
+		})
+	}
+
+	return sprints, nil
+}
+
+// after returns the time at which the given number of sprints after Start end, whole days
+// are added as calendar days so that daylight saving time changes do not move the time of day
+func (c *SprintCadence) after(sprints int) time.Time {
+	length := time.Duration(sprints) * c.Length
+	days := length / (24 * time.Hour)
+	return c.Start.AddDate(0, 0, int(days)).Add(length - days*24*time.Hour)
+}
+
+// shift moves the time by one day in the given direction until it is a working day
+func (c *SprintCadence) shift(t time.Time, direction int) time.Time {
+	// a year of non-working days means that the configuration is wrong
+	for i := 0; i < 366 && !c.working(t); i++ {
+		t = t.AddDate(0, 0, direction)
+	}
+	return t
+}
+
+func (c *SprintCadence) working(t time.Time) bool {
+	nonWorking := c.NonWorkingDays
+	if nonWorking == nil {
+		nonWorking = []time.Weekday{time.Saturday, time.Sunday}
+	}
+
+	for _, d := range nonWorking {
+		if t.Weekday() == d {
+			return false
+		}
+	}
+
+	y, m, d := t.Date()
+	for _, h := range c.Holidays {
+		hy, hm, hd := h.Date()
+		if y == hy && m == hm && d == hd {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CreateCadence creates the future sprints of the cadence (see SprintCadence.Plan). Sprints
+// whose name already exists on the board are skipped, so a cadence can be created again
+// without duplicating sprints.
+//
+// GET /rest/agile/1.0/board/{boardId}/sprint
+// POST /rest/agile/1.0/sprint
+func (s *SprintsService) CreateCadence(ctx context.Context, cadence *SprintCadence) (*CadenceResult, *Response, error) {
+
+	planned, err := cadence.Plan()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing := map[string]bool{}
+	opts := &SprintsOptions{}
+	var resp *Response
+	for {
+		var sprints []*Sprint
+		sprints, resp, err = s.client.Boards.ListSprints(ctx, cadence.BoardID, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, sprint := range sprints {
+			existing[strings.TrimSpace(sprint.Name)] = true
+		}
+
+		if resp.IsLast || len(sprints) == 0 {
+			break
+		}
+		opts.StartAt += len(sprints)
+	}
+
+	result := &CadenceResult{}
+	for _, newSprint := range planned {
+		if existing[newSprint.Name] {
+			result.Skipped = append(result.Skipped, newSprint)
+			continue
+		}
+
+		var sprint *Sprint
+		sprint, resp, err = s.Create(ctx, newSprint)
+		if err != nil {
+			return result, resp, err
+		}
+		result.Created = append(result.Created, sprint)
+		existing[newSprint.Name] = true
+	}
+
+	return result, resp, nil
+}
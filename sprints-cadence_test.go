@@ -0,0 +1,131 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSprintCadencePlan(t *testing.T) {
+	cadence := &SprintCadence{
+		BoardID:      84,
+		NameTemplate: "Team {{.N}} {{.Start | date}}",
+		FirstNumber:  7,
+		// a Monday
+		Start:    time.Date(2019, 12, 16, 9, 0, 0, 0, time.UTC),
+		Length:   14 * 24 * time.Hour,
+		Count:    3,
+		Holidays: []time.Time{time.Date(2019, 12, 30, 0, 0, 0, 0, time.UTC)},
+	}
+
+	sprints, err := cadence.Plan()
+	assert.Nil(t, err)
+	assert.Len(t, sprints, 3)
+
+	assert.Equal(t, "Team 7 2019-12-16", sprints[0].Name)
+	assert.Equal(t, 84, sprints[0].BoardID)
+	assert.Equal(t, time.Date(2019, 12, 16, 9, 0, 0, 0, time.UTC), *sprints[0].Start)
+	// the nominal end is a holiday, moved back to the previous working day
+	assert.Equal(t, time.Date(2019, 12, 27, 9, 0, 0, 0, time.UTC), *sprints[0].End)
+
+	// the nominal start is a holiday, moved forward to the next working day
+	assert.Equal(t, "Team 8 2019-12-31", sprints[1].Name)
+	assert.Equal(t, time.Date(2020, 1, 13, 9, 0, 0, 0, time.UTC), *sprints[1].End)
+
+	assert.Equal(t, "Team 9 2020-01-13", sprints[2].Name)
+}
+
+func TestSprintCadencePlanNonWorkingDays(t *testing.T) {
+	cadence := &SprintCadence{
+		NameTemplate:   "S{{.N}} until {{.End | date}}",
+		DateFormat:     "Jan 2",
+		Start:          time.Date(2019, 12, 13, 9, 0, 0, 0, time.UTC), // a Friday
+		Length:         7 * 24 * time.Hour,
+		Count:          1,
+		NonWorkingDays: []time.Weekday{time.Friday},
+	}
+
+	sprints, err := cadence.Plan()
+	assert.Nil(t, err)
+	assert.Equal(t, "S1 until Dec 19", sprints[0].Name)
+	assert.Equal(t, time.Saturday, sprints[0].Start.Weekday())
+}
+
+func TestSprintCadencePlanDaylightSaving(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("no time zone database:", err)
+	}
+
+	// daylight saving time ends on 2026-10-25, during the first sprint
+	cadence := &SprintCadence{
+		NameTemplate: "S{{.N}}",
+		Start:        time.Date(2026, 10, 12, 0, 0, 0, 0, berlin), // a Monday
+		Length:       14 * 24 * time.Hour,
+		Count:        2,
+	}
+
+	sprints, err := cadence.Plan()
+	assert.Nil(t, err)
+	// still at midnight, not on Sunday 23:00 moved to Friday and Monday
+	assert.Equal(t, time.Date(2026, 10, 26, 0, 0, 0, 0, berlin), *sprints[0].End)
+	assert.Equal(t, time.Date(2026, 10, 26, 0, 0, 0, 0, berlin), *sprints[1].Start)
+	assert.Equal(t, time.Date(2026, 11, 9, 0, 0, 0, 0, berlin), *sprints[1].End)
+}
+
+func TestSprintCadencePlanErrors(t *testing.T) {
+	start := time.Date(2019, 12, 16, 9, 0, 0, 0, time.UTC)
+
+	tests := []*SprintCadence{
+		{Start: start, Length: time.Hour, Count: 1},
+		{NameTemplate: "S", Start: start, Count: 1},
+		{NameTemplate: "S", Length: time.Hour, Count: 1},
+		{NameTemplate: "{{.Foo", Start: start, Length: time.Hour, Count: 1},
+		{NameTemplate: "{{.Foo}}", Start: start, Length: time.Hour, Count: 1},
+		{NameTemplate: "S", Start: time.Date(2019, 12, 14, 9, 0, 0, 0, time.UTC), Length: 24 * time.Hour, Count: 1},
+	}
+
+	for _, cadence := range tests {
+		_, err := cadence.Plan()
+		assert.NotNil(t, err)
+	}
+}
+
+func TestSprintsServiceCreateCadence(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/84/sprint", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"isLast": true,"values": [{"id": 1,"name": "Team 1","state": "active"}]}`)
+	})
+
+	var created []string
+	mux.HandleFunc("/sprint", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		newSprint := &NewSprint{}
+		json.NewDecoder(r.Body).Decode(newSprint)
+		created = append(created, newSprint.Name)
+		fmt.Fprintf(w, `{"id": %d,"name": "%s","state": "future","originBoardId": 84}`, len(created)+1, newSprint.Name)
+	})
+
+	cadence := &SprintCadence{
+		BoardID:      84,
+		NameTemplate: "Team {{.N}}",
+		Start:        time.Date(2019, 12, 16, 9, 0, 0, 0, time.UTC),
+		Length:       14 * 24 * time.Hour,
+		Count:        3,
+	}
+
+	result, _, err := client.Sprints.CreateCadence(context.Background(), cadence)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Team 2", "Team 3"}, created)
+	assert.Len(t, result.Created, 2)
+	assert.Len(t, result.Skipped, 1)
+	assert.Equal(t, "Team 1", result.Skipped[0].Name)
+}
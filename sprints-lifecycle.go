@@ -0,0 +1,176 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Sprint states
+const (
+	SprintStateFuture = "future"
+	SprintStateActive = "active"
+	SprintStateClosed = "closed"
+)
+
+// SprintStateError is returned when a sprint is not in the state required by an operation
+type SprintStateError struct {
+	SprintID int
+	State    string
+	Expected string
+	Op       string
+}
+
+func (e *SprintStateError) Error() string {
+	return fmt.Sprintf("jira: cannot %s sprint %d, it is %s and must be %s", e.Op, e.SprintID, e.State, e.Expected)
+}
+
+// CompleteSprintOptions contains the options to complete a sprint. Incomplete issues are
+// moved to the sprint MoveToSprintID, to a sprint created from NewSprint, or to the
+// backlog when both are empty.
+type CompleteSprintOptions struct {
+	//Id of a future sprint that receives the incomplete issues.
+	MoveToSprintID int
+	//Sprint created to receive the incomplete issues, when MoveToSprintID is not defined.
+	//The board of the completed sprint is used when BoardID is not defined.
+	NewSprint *NewSprint
+}
+
+// SprintCompletion contains the result of completing a sprint
+type SprintCompletion struct {
+	//The completed sprint.
+	Sprint *Sprint
+	//The sprint that received the incomplete issues, nil when they were moved to the backlog.
+	MovedTo *Sprint
+	//The outcome of moving each incomplete issue.
+	Moved *BulkResult
+}
+
+// Start starts a future sprint, setting its start and end dates and, when it is not
+// empty, its goal. An error is returned, without changing the sprint, if the sprint is
+// not in the future state or the dates are invalid.
+//
+// GET /rest/agile/1.0/sprint/{sprintId}
+// POST /rest/agile/1.0/sprint/{sprintId}
+func (s *SprintsService) Start(ctx context.Context, sprintID int, start, end time.Time, goal string) (*Sprint, *Response, error) {
+
+	if start.IsZero() || end.IsZero() {
+		return nil, nil, errors.New("jira: start and end dates are required to start a sprint")
+	}
+	if !end.After(start) {
+		return nil, nil, fmt.Errorf("jira: sprint end date %v must be after its start date %v", end, start)
+	}
+
+	sprint, resp, err := s.Get(ctx, sprintID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if sprint.State != SprintStateFuture {
+		return nil, resp, &SprintStateError{SprintID: sprintID, State: sprint.State, Expected: SprintStateFuture, Op: "start"}
+	}
+
+	update := &Sprint{
+		State: SprintStateActive,
+		Start: &start,
+		End:   &end,
+		Goal:  goal,
+	}
+
+	return s.PartiallyUpdate(ctx, sprintID, update)
+}
+
+// Complete completes an active sprint. Its incomplete issues, the ones whose status is
+// not in the done category, are moved first as defined by the options. The sprint is not
+// completed when any issue can not be moved. An error is returned, without changing
+// anything, if the sprint is not active or the destination sprint is not a future sprint.
+//
+// GET /rest/agile/1.0/sprint/{sprintId}
+// GET /rest/agile/1.0/sprint/{sprintId}/issue
+// POST /rest/agile/1.0/sprint/{sprintId}/issue or POST /rest/agile/1.0/backlog/issue
+// POST /rest/agile/1.0/sprint/{sprintId}
+func (s *SprintsService) Complete(ctx context.Context, sprintID int, opts *CompleteSprintOptions) (*SprintCompletion, *Response, error) {
+
+	if opts == nil {
+		opts = &CompleteSprintOptions{}
+	}
+
+	sprint, resp, err := s.Get(ctx, sprintID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if sprint.State != SprintStateActive {
+		return nil, resp, &SprintStateError{SprintID: sprintID, State: sprint.State, Expected: SprintStateActive, Op: "complete"}
+	}
+
+	var target *Sprint
+	if opts.MoveToSprintID != 0 {
+		if opts.MoveToSprintID == sprintID {
+			return nil, resp, fmt.Errorf("jira: cannot move the incomplete issues of sprint %d to itself", sprintID)
+		}
+
+		target, resp, err = s.Get(ctx, opts.MoveToSprintID)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		if target.State != SprintStateFuture {
+			return nil, resp, &SprintStateError{SprintID: target.ID, State: target.State, Expected: SprintStateFuture, Op: "move issues to"}
+		}
+	}
+
+	list := func(ctx context.Context, o *IssuesOptions) ([]*Issue, *Response, error) {
+		return s.ListIssues(ctx, sprintID, o)
+	}
+
+	issues, resp, err := listAllIssues(ctx, &IssuesOptions{Fields: "status"}, list)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	incomplete := &IssueKeys{}
+	for _, issue := range issues {
+		if issue.Fields == nil || issue.Fields.Status == nil || issue.Fields.Status.Category == nil ||
+			issue.Fields.Status.Category.Key != "done" {
+			incomplete.Issues = append(incomplete.Issues, issue.Key)
+		}
+	}
+
+	if target == nil && opts.NewSprint != nil && len(incomplete.Issues) > 0 {
+		newSprint := *opts.NewSprint
+		if newSprint.BoardID == 0 {
+			newSprint.BoardID = sprint.BoardID
+		}
+
+		target, resp, err = s.Create(ctx, &newSprint)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	completion := &SprintCompletion{MovedTo: target, Moved: &BulkResult{}}
+
+	if len(incomplete.Issues) > 0 {
+		if target != nil {
+			completion.Moved, resp, err = s.BulkMoveIssuesTo(ctx, target.ID, incomplete)
+		} else {
+			completion.Moved, resp, err = s.client.Backlog.BulkMoveIssuesTo(ctx, incomplete)
+		}
+		if err != nil {
+			return completion, resp, err
+		}
+
+		if failed := completion.Moved.Failed(); len(failed) > 0 {
+			return completion, resp, fmt.Errorf("jira: %d incomplete issues could not be moved, sprint %d was not completed", len(failed), sprintID)
+		}
+	}
+
+	completion.Sprint, resp, err = s.PartiallyUpdate(ctx, sprintID, &Sprint{State: SprintStateClosed})
+	if err != nil {
+		return completion, resp, err
+	}
+
+	return completion, resp, nil
+}
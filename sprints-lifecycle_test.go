@@ -0,0 +1,179 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sprintIssuesAsJSON = `{"startAt": 0,"maxResults": 50,"issues": [
+	{"key": "MCP-1","fields": {"status": {"name": "Done","statusCategory": {"key": "done"}}}},
+	{"key": "MCP-2","fields": {"status": {"name": "In Progress","statusCategory": {"key": "indeterminate"}}}},
+	{"key": "MCP-3","fields": {"status": {"name": "To Do","statusCategory": {"key": "new"}}}}]}`
+
+func TestSprintsServiceStart(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"id": 1,"state": "future","name": "Sprint 1","originBoardId": 84}`)
+			return
+		}
+
+		assert.Equal(t, "POST", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"state": "active","startDate": "2019-01-07T09:00:00Z","endDate": "2019-01-18T18:00:00Z","goal": "Ship it"}`, string(body))
+		fmt.Fprint(w, `{"id": 1,"state": "active","name": "Sprint 1","goal": "Ship it"}`)
+	})
+	mux.HandleFunc("/sprint/2", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": 2,"state": "active"}`)
+	})
+
+	start := time.Date(2019, 1, 7, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 1, 18, 18, 0, 0, 0, time.UTC)
+
+	sprint, _, err := client.Sprints.Start(context.Background(), 1, start, end, "Ship it")
+	assert.Nil(t, err)
+	assert.Equal(t, SprintStateActive, sprint.State)
+
+	_, _, err = client.Sprints.Start(context.Background(), 2, start, end, "")
+	stateErr, ok := err.(*SprintStateError)
+	assert.True(t, ok)
+	assert.Equal(t, SprintStateActive, stateErr.State)
+	assert.Equal(t, "jira: cannot start sprint 2, it is active and must be future", err.Error())
+
+	_, _, err = client.Sprints.Start(context.Background(), 1, time.Time{}, end, "")
+	assert.NotNil(t, err)
+
+	_, _, err = client.Sprints.Start(context.Background(), 1, end, start, "")
+	assert.NotNil(t, err)
+}
+
+func TestSprintsServiceCompleteToBacklog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	closed := false
+	mux.HandleFunc("/sprint/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"id": 1,"state": "active","originBoardId": 84}`)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"state": "closed"}`, string(body))
+		closed = true
+		fmt.Fprint(w, `{"id": 1,"state": "closed"}`)
+	})
+	mux.HandleFunc("/sprint/1/issue", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, sprintIssuesAsJSON)
+	})
+	mux.HandleFunc("/backlog/issue", func(w http.ResponseWriter, r *http.Request) {
+		keys := &IssueKeys{}
+		json.NewDecoder(r.Body).Decode(keys)
+		assert.Equal(t, []string{"MCP-2", "MCP-3"}, keys.Issues)
+		assert.False(t, closed)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	completion, _, err := client.Sprints.Complete(context.Background(), 1, nil)
+	assert.Nil(t, err)
+	assert.True(t, closed)
+	assert.Equal(t, SprintStateClosed, completion.Sprint.State)
+	assert.Nil(t, completion.MovedTo)
+	assert.Len(t, completion.Moved.Entries, 2)
+}
+
+func TestSprintsServiceCompleteToNewSprint(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"id": 1,"state": "active","originBoardId": 84}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1,"state": "closed"}`)
+	})
+	mux.HandleFunc("/sprint/1/issue", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, sprintIssuesAsJSON)
+	})
+	mux.HandleFunc("/sprint", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "Sprint 2","originBoardId": 84}`, string(body))
+		fmt.Fprint(w, `{"id": 2,"state": "future","name": "Sprint 2","originBoardId": 84}`)
+	})
+	mux.HandleFunc("/sprint/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	completion, _, err := client.Sprints.Complete(context.Background(), 1, &CompleteSprintOptions{NewSprint: &NewSprint{Name: "Sprint 2"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, completion.MovedTo.ID)
+	assert.True(t, completion.Moved.OK())
+}
+
+func TestSprintsServiceCompletePreconditions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": 1,"state": "active"}`)
+	})
+	mux.HandleFunc("/sprint/2", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": 2,"state": "closed"}`)
+	})
+	mux.HandleFunc("/sprint/3", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": 3,"state": "future"}`)
+	})
+
+	_, _, err := client.Sprints.Complete(context.Background(), 2, nil)
+	stateErr, ok := err.(*SprintStateError)
+	assert.True(t, ok)
+	assert.Equal(t, SprintStateActive, stateErr.Expected)
+
+	_, _, err = client.Sprints.Complete(context.Background(), 1, &CompleteSprintOptions{MoveToSprintID: 2})
+	stateErr, ok = err.(*SprintStateError)
+	assert.True(t, ok)
+	assert.Equal(t, 2, stateErr.SprintID)
+	assert.Equal(t, SprintStateFuture, stateErr.Expected)
+
+	_, _, err = client.Sprints.Complete(context.Background(), 1, &CompleteSprintOptions{MoveToSprintID: 1})
+	assert.NotNil(t, err)
+
+	_, _, err = client.Sprints.Complete(context.Background(), 3, nil)
+	assert.NotNil(t, err)
+}
+
+func TestSprintsServiceCompleteMoveFailure(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "the sprint must not be completed")
+		fmt.Fprint(w, `{"id": 1,"state": "active"}`)
+	})
+	mux.HandleFunc("/sprint/1/issue", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, sprintIssuesAsJSON)
+	})
+	mux.HandleFunc("/backlog/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	completion, _, err := client.Sprints.Complete(context.Background(), 1, nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, completion.Sprint)
+	assert.Len(t, completion.Moved.Failed(), 2)
+}
@@ -0,0 +1,133 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ListPropertyKeys returns the keys of all properties for the sprint, for the given sprint Id.
+// The user who retrieves the property keys is required to have permissions to view the sprint.
+//
+// GET /rest/agile/1.0/sprint/{sprintId}/properties
+func (s *SprintsService) ListPropertyKeys(ctx context.Context, sprintID int) ([]*PropertyKey, *Response, error) {
+
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("sprint/%d/properties", sprintID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys = &PropertyKeys{}
+	resp, err := s.client.Do(ctx, req, keys)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keys.Keys, resp, nil
+}
+
+// GetProperty returns the value of the property with the given key from the sprint, for the
+// given sprint Id. The user who retrieves the property is required to have permissions to view
+// the sprint. A missing property returns an *ErrorResponse with the 404 status code.
+//
+// GET /rest/agile/1.0/sprint/{sprintId}/properties/{propertyKey}
+func (s *SprintsService) GetProperty(ctx context.Context, sprintID int, key string) (*Property, *Response, error) {
+
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("sprint/%d/properties/%s", sprintID, url.PathEscape(key)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var property = &Property{}
+	resp, err := s.client.Do(ctx, req, property)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return property, resp, nil
+}
+
+// GetPropertyValue returns the property with the given key from the sprint, decoding its
+// value into the value pointed to by v.
+//
+// GET /rest/agile/1.0/sprint/{sprintId}/properties/{propertyKey}
+func (s *SprintsService) GetPropertyValue(ctx context.Context, sprintID int, key string, v interface{}) (*Response, error) {
+
+	property, resp, err := s.GetProperty(ctx, sprintID, key)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, property.Unmarshal(v)
+}
+
+// SetProperty sets the value of the specified sprint's property. The value is JSON encoded,
+// it can be any value that is valid JSON and its maximum length is 32768 bytes. The user who
+// sets the property is required to have permissions to modify the sprint.
+//
+// PUT /rest/agile/1.0/sprint/{sprintId}/properties/{propertyKey}
+func (s *SprintsService) SetProperty(ctx context.Context, sprintID int, key string, value interface{}) (bool, *Response, error) {
+
+	req, err := s.client.NewRequest("PUT", fmt.Sprintf("sprint/%d/properties/%s", sprintID, url.PathEscape(key)), value)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// UpdateProperty performs a read-modify-write of the sprint's property. The current value is
+// decoded into the value pointed to by v (a missing property leaves v zeroed) and update is
+// called to modify it. Right before the new value is written, the property is read again and,
+// if it was changed in the meantime, the whole cycle is restarted. The property is also read
+// after the write and the cycle is restarted when it no longer holds the written value.
+// ErrPropertyConflict is returned once the attempts set in the options, 5 by default, are used.
+//
+// Jira has no conditional writes for properties, so concurrent writers can still lose updates:
+// when two writers both pass the read made right before the write, the second write overwrites
+// the first one even after the first writer has checked it, and both calls succeed. The
+// checks only narrow that window, a lock held outside of Jira is needed to close it.
+func (s *SprintsService) UpdateProperty(ctx context.Context, sprintID int, key string, v interface{}, update func() error, opts *UpdatePropertyOptions) (*Response, error) {
+
+	get := func(ctx context.Context) (*Property, *Response, error) {
+		return s.GetProperty(ctx, sprintID, key)
+	}
+	set := func(ctx context.Context, value interface{}) (bool, *Response, error) {
+		return s.SetProperty(ctx, sprintID, key, value)
+	}
+
+	return updateProperty(ctx, get, set, v, update, opts)
+}
+
+// DeleteProperty removes the property from the sprint identified by the id. The user who removes
+// the property is required to have permissions to modify the sprint.
+//
+// DELETE /rest/agile/1.0/sprint/{sprintId}/properties/{propertyKey}
+func (s *SprintsService) DeleteProperty(ctx context.Context, sprintID int, key string) (bool, *Response, error) {
+
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("sprint/%d/properties/%s", sprintID, url.PathEscape(key)), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
@@ -0,0 +1,228 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type retrospective struct {
+	Link     string `json:"link,omitempty"`
+	Capacity int    `json:"capacity,omitempty"`
+	Team     string `json:"team,omitempty"`
+}
+
+func TestSprintsServiceListPropertyKeys(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/11392/properties", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"keys": [{"self": "https://jira.mycompany.com/rest/agile/1.0/sprint/11392/properties/retro","key": "retro"}]}`)
+	})
+
+	keys, _, err := client.Sprints.ListPropertyKeys(context.Background(), 11392)
+	assert.Nil(t, err)
+	assert.Len(t, keys, 1)
+	assert.Equal(t, "retro", keys[0].Key)
+}
+
+func TestSprintsServiceGetProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/11392/properties/retro", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"key": "retro","value": {"link": "https://wiki.mycompany.com/retro","capacity": 40}}`)
+	})
+
+	retro := &retrospective{}
+	_, err := client.Sprints.GetPropertyValue(context.Background(), 11392, "retro", retro)
+	assert.Nil(t, err)
+	assert.True(t, reflect.DeepEqual(retro, &retrospective{Link: "https://wiki.mycompany.com/retro", Capacity: 40}))
+
+	_, err = client.Sprints.GetPropertyValue(context.Background(), 11392, "missing", retro)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestSprintsServiceSetProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/11392/properties/retro", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"team": "core"}`, string(body))
+	})
+
+	ok, _, err := client.Sprints.SetProperty(context.Background(), 11392, "retro", &retrospective{Team: "core"})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestSprintsServiceDeleteProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/11392/properties/retro", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ok, _, err := client.Sprints.DeleteProperty(context.Background(), 11392, "retro")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestSprintsServiceUpdateProperty(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Stored   []string
+		Attempts int
+		Want     string
+		Err      error
+	}{
+		{
+			Name:   "missing property",
+			Stored: []string{"", ""},
+			Want:   `{"capacity": 1}`,
+		},
+		{
+			Name:   "existing property",
+			Stored: []string{`{"team": "core","capacity": 2}`, `{"capacity":2, "team":"core"}`},
+			Want:   `{"team": "core","capacity": 3}`,
+		},
+		{
+			Name:   "concurrent change",
+			Stored: []string{`{"capacity": 2}`, `{"capacity": 5}`, `{"capacity": 5}`, `{"capacity": 5}`},
+			Want:   `{"capacity": 6}`,
+		},
+		{
+			Name:   "overwritten after the write",
+			Stored: []string{`{"capacity": 2}`, `{"capacity": 2}`, `{"capacity": 9}`, `{"capacity": 9}`, `{"capacity": 9}`},
+			Want:   `{"capacity": 10}`,
+		},
+		{
+			Name:     "conflict",
+			Stored:   []string{`{"capacity": 1}`, `{"capacity": 2}`, `{"capacity": 3}`, `{"capacity": 4}`},
+			Attempts: 2,
+			Err:      ErrPropertyConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			reads := 0
+			var written string
+			mux.HandleFunc("/sprint/11392/properties/retro", func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case "GET":
+					// once the stored values are used, the property holds what was written
+					stored := written
+					if reads < len(tt.Stored) {
+						stored = tt.Stored[reads]
+					}
+					reads++
+					if stored == "" {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					fmt.Fprintf(w, `{"key": "retro","value": %s}`, stored)
+				case "PUT":
+					body, _ := ioutil.ReadAll(r.Body)
+					written = string(body)
+				}
+			})
+
+			retro := &retrospective{}
+			_, err := client.Sprints.UpdateProperty(context.Background(), 11392, "retro", retro, func() error {
+				retro.Capacity++
+				return nil
+			}, &UpdatePropertyOptions{MaxAttempts: tt.Attempts})
+
+			assert.Equal(t, tt.Err, err)
+			if tt.Err == nil {
+				assert.JSONEq(t, tt.Want, written)
+			} else {
+				assert.Empty(t, written)
+			}
+		})
+	}
+}
+
+func TestSprintsServiceUpdatePropertyAbort(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/11392/properties/retro", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"key": "retro","value": {"capacity": 2}}`)
+	})
+
+	abort := fmt.Errorf("abort")
+	_, err := client.Sprints.UpdateProperty(context.Background(), 11392, "retro", &json.RawMessage{}, func() error {
+		return abort
+	}, nil)
+	assert.Equal(t, abort, err)
+}
+
+func TestSprintsServiceUpdatePropertyLostUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	stored := `{"capacity": 1}`
+	bWriting := make(chan struct{})
+	aDone := make(chan struct{})
+
+	mux.HandleFunc("/sprint/11392/properties/retro", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			mu.Lock()
+			fmt.Fprintf(w, `{"key": "retro","value": %s}`, stored)
+			mu.Unlock()
+		case "PUT":
+			body, _ := ioutil.ReadAll(r.Body)
+			// B has passed its checks, its write is held until A is done
+			if strings.Contains(string(body), `"b"`) {
+				close(bWriting)
+				<-aDone
+			}
+			mu.Lock()
+			stored = string(body)
+			mu.Unlock()
+		}
+	})
+
+	update := func(team string) error {
+		retro := &retrospective{}
+		_, err := client.Sprints.UpdateProperty(context.Background(), 11392, "retro", retro, func() error {
+			retro.Capacity++
+			retro.Team = team
+			return nil
+		}, nil)
+		return err
+	}
+
+	errB := make(chan error)
+	go func() { errB <- update("b") }()
+
+	<-bWriting
+	assert.Nil(t, update("a"))
+	close(aDone)
+	assert.Nil(t, <-errB)
+
+	// both writers succeeded, but A's change was overwritten by B
+	assert.JSONEq(t, `{"capacity": 2,"team": "b"}`, stored)
+}
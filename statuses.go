@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// StatusesService handles communication with the status related
+// methods of the Jira platform API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/status
+type StatusesService service
+
+// List returns the statuses visible to the user, with their status category.
+//
+// GET /rest/api/2/status
+func (s *StatusesService) List(ctx context.Context) ([]*IssueStatus, *Response, error) {
+
+	req, err := s.client.NewRequest("GET", apiPath+"status", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statuses []*IssueStatus
+	resp, err := s.client.Do(ctx, req, &statuses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return statuses, resp, nil
+}
+
+// Get returns the status for the given status id or name.
+//
+// GET /rest/api/2/status/{idOrName}
+func (s *StatusesService) Get(ctx context.Context, idOrName string) (*IssueStatus, *Response, error) {
+
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("%sstatus/%s", apiPath, idOrName), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var status = &IssueStatus{}
+	resp, err := s.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
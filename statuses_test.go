@@ -0,0 +1,41 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusesServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/status", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `[{"id": "1","name": "Open","statusCategory": {"id": 2,"key": "new","name": "To Do"}},
+			{"id": "3","name": "In Progress","statusCategory": {"id": 4,"key": "indeterminate","name": "In Progress"}}]`)
+	})
+
+	statuses, _, err := client.Statuses.List(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, "In Progress", statuses[1].Name)
+	assert.Equal(t, StatusCategoryInProgress, statuses[1].Category.Key)
+}
+
+func TestStatusesServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/status/3", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": "3","name": "In Progress","statusCategory": {"id": 4,"key": "indeterminate"}}`)
+	})
+
+	status, _, err := client.Statuses.Get(context.Background(), "3")
+	assert.Nil(t, err)
+	assert.Equal(t, "In Progress", status.Name)
+}
@@ -1,5 +1,11 @@
 package jira
 
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
 // VersionWrap represents the data returned by the API,
 // in addition to the board information, paging data is returned
 type VersionWrap struct {
@@ -16,6 +22,40 @@ type Version struct {
 	Archived    bool   `json:"archived,omitempty"`
 	Released    bool   `json:"released,omitempty"`
 	ProjectID   int    `json:"projectId,omitempty"`
+	//Key of the project of the version, only used to create versions.
+	Project         string `json:"project,omitempty"`
+	StartDate       *Date  `json:"startDate,omitempty"`
+	ReleaseDate     *Date  `json:"releaseDate,omitempty"`
+	UserStartDate   string `json:"userStartDate,omitempty"`
+	UserReleaseDate string `json:"userReleaseDate,omitempty"`
+	Overdue         bool   `json:"overdue,omitempty"`
+}
+
+// UnmarshalJSON decodes the version, the id is a number in the Jira Agile API and
+// a string in the Jira platform API
+func (v *Version) UnmarshalJSON(b []byte) error {
+	type version Version
+	aux := struct {
+		*version
+		ID json.RawMessage `json:"id,omitempty"`
+	}{version: (*version)(v)}
+
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	id := strings.Trim(string(aux.ID), "\"")
+	if id == "" || id == "null" {
+		return nil
+	}
+
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return err
+	}
+	v.ID = n
+
+	return nil
 }
 
 // VersionsOptions contains all options to list all versions from the board
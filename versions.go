@@ -0,0 +1,258 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// VersionsService handles communication with the version related
+// methods of the Jira platform API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/version
+type VersionsService service
+
+// Positions of a version, see VersionMove
+const (
+	VersionPositionFirst   = "First"
+	VersionPositionLast    = "Last"
+	VersionPositionEarlier = "Earlier"
+	VersionPositionLater   = "Later"
+)
+
+// VersionMove contains the new position of a version, either a Position or the self
+// link of the version it is moved After
+type VersionMove struct {
+	Position string `json:"position,omitempty"`
+	After    string `json:"after,omitempty"`
+}
+
+// VersionIssueCounts contains the number of issues related to a version
+type VersionIssueCounts struct {
+	SelfLink      string `json:"self,omitempty"`
+	FixedCount    int    `json:"issuesFixedCount"`
+	AffectedCount int    `json:"issuesAffectedCount"`
+}
+
+// VersionUnresolvedCount contains the number of unresolved issues of a version
+type VersionUnresolvedCount struct {
+	SelfLink        string `json:"self,omitempty"`
+	UnresolvedCount int    `json:"issuesUnresolvedCount"`
+}
+
+// DeleteVersionOptions contains the options to delete a version
+type DeleteVersionOptions struct {
+	//The version to set as fixVersion on issues where the deleted version is the fix version. If empty then the fixVersion is removed.
+	MoveFixIssuesTo int `query:"moveFixIssuesTo"`
+	//The version to set as affectedVersion on issues where the deleted version is the affected version. If empty then the affectedVersion is removed.
+	MoveAffectedIssuesTo int `query:"moveAffectedIssuesTo"`
+}
+
+// ListForProject returns all versions of a project, for the given project id or key.
+//
+// GET /rest/api/2/project/{projectIdOrKey}/versions
+func (v *VersionsService) ListForProject(ctx context.Context, projectIDOrKey string) ([]*Version, *Response, error) {
+
+	req, err := v.client.NewRequest("GET", fmt.Sprintf("%sproject/%s/versions", apiPath, projectIDOrKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var versions []*Version
+	resp, err := v.client.Do(ctx, req, &versions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return versions, resp, nil
+}
+
+// Get returns the version for the given version id.
+//
+// GET /rest/api/2/version/{id}
+func (v *VersionsService) Get(ctx context.Context, versionID int) (*Version, *Response, error) {
+
+	req, err := v.client.NewRequest("GET", fmt.Sprintf("%sversion/%d", apiPath, versionID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var version = &Version{}
+	resp, err := v.client.Do(ctx, req, version)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return version, resp, nil
+}
+
+// Create creates a version. The project is defined by Project (its key) or ProjectID.
+//
+// POST /rest/api/2/version
+func (v *VersionsService) Create(ctx context.Context, version *Version) (*Version, *Response, error) {
+
+	req, err := v.client.NewRequest("POST", apiPath+"version", version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created = &Version{}
+	resp, err := v.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// Update updates a version, only the fields present in the request are updated. Use
+// Release and Archive to change the released and archived flags, which are omitted when
+// they are false.
+//
+// PUT /rest/api/2/version/{id}
+func (v *VersionsService) Update(ctx context.Context, versionID int, version *Version) (*Version, *Response, error) {
+	u := *version
+	u.ID = 0
+	return v.update(ctx, versionID, &u)
+}
+
+func (v *VersionsService) update(ctx context.Context, versionID int, body interface{}) (*Version, *Response, error) {
+
+	req, err := v.client.NewRequest("PUT", fmt.Sprintf("%sversion/%d", apiPath, versionID), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated = &Version{}
+	resp, err := v.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, nil
+}
+
+// Release releases the version on the given date, or unreleases it when released is false.
+// The release date is not changed when it is zero.
+//
+// PUT /rest/api/2/version/{id}
+func (v *VersionsService) Release(ctx context.Context, versionID int, released bool, releaseDate time.Time) (*Version, *Response, error) {
+	body := map[string]interface{}{"released": released}
+	if !releaseDate.IsZero() {
+		body["releaseDate"] = NewDate(releaseDate)
+	}
+	return v.update(ctx, versionID, body)
+}
+
+// Archive archives the version, or unarchives it when archived is false.
+//
+// PUT /rest/api/2/version/{id}
+func (v *VersionsService) Archive(ctx context.Context, versionID int, archived bool) (*Version, *Response, error) {
+	return v.update(ctx, versionID, map[string]interface{}{"archived": archived})
+}
+
+// Merge merges the version into another one, the issues of the version are moved to the
+// other version and the version is deleted.
+//
+// PUT /rest/api/2/version/{id}/mergeto/{moveIssuesTo}
+func (v *VersionsService) Merge(ctx context.Context, versionID int, moveIssuesTo int) (bool, *Response, error) {
+
+	req, err := v.client.NewRequest("PUT", fmt.Sprintf("%sversion/%d/mergeto/%d", apiPath, versionID, moveIssuesTo), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := v.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// Move changes the position of the version in the sequence of versions of its project.
+//
+// POST /rest/api/2/version/{id}/move
+func (v *VersionsService) Move(ctx context.Context, versionID int, move *VersionMove) (*Version, *Response, error) {
+
+	req, err := v.client.NewRequest("POST", fmt.Sprintf("%sversion/%d/move", apiPath, versionID), move)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var moved = &Version{}
+	resp, err := v.client.Do(ctx, req, moved)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return moved, resp, nil
+}
+
+// Delete deletes the version. Its issues are moved to the versions defined by the options,
+// the version is removed from the issues otherwise.
+//
+// DELETE /rest/api/2/version/{id}
+func (v *VersionsService) Delete(ctx context.Context, versionID int, opts *DeleteVersionOptions) (bool, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := v.client.NewRequest("DELETE", fmt.Sprintf("%sversion/%d%s", apiPath, versionID, q), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := v.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// GetRelatedIssueCounts returns the number of issues fixed in and affected by the version.
+//
+// GET /rest/api/2/version/{id}/relatedIssueCounts
+func (v *VersionsService) GetRelatedIssueCounts(ctx context.Context, versionID int) (*VersionIssueCounts, *Response, error) {
+
+	req, err := v.client.NewRequest("GET", fmt.Sprintf("%sversion/%d/relatedIssueCounts", apiPath, versionID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var counts = &VersionIssueCounts{}
+	resp, err := v.client.Do(ctx, req, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return counts, resp, nil
+}
+
+// GetUnresolvedIssueCount returns the number of unresolved issues of the version.
+//
+// GET /rest/api/2/version/{id}/unresolvedIssueCount
+func (v *VersionsService) GetUnresolvedIssueCount(ctx context.Context, versionID int) (*VersionUnresolvedCount, *Response, error) {
+
+	req, err := v.client.NewRequest("GET", fmt.Sprintf("%sversion/%d/unresolvedIssueCount", apiPath, versionID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var count = &VersionUnresolvedCount{}
+	resp, err := v.client.Do(ctx, req, count)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return count, resp, nil
+}
@@ -0,0 +1,174 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const versionJSON = `{"self": "http://www.example.com/jira/rest/api/2/version/10000","id": "10000","name": "New Version 1",
+	"description": "An excellent version","archived": false,"released": true,"startDate": "2010-07-01",
+	"releaseDate": "2010-07-06","overdue": true,"userReleaseDate": "6/Jul/2010","projectId": 10000}`
+
+func TestVersionsServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/version/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, versionJSON)
+	})
+
+	version, _, err := client.Versions.Get(context.Background(), 10000)
+	assert.Nil(t, err)
+
+	want := &Version{
+		ID:              10000,
+		Name:            "New Version 1",
+		SelfLink:        "http://www.example.com/jira/rest/api/2/version/10000",
+		Description:     "An excellent version",
+		Released:        true,
+		ProjectID:       10000,
+		StartDate:       NewDate(time.Date(2010, 7, 1, 0, 0, 0, 0, time.UTC)),
+		ReleaseDate:     NewDate(time.Date(2010, 7, 6, 0, 0, 0, 0, time.UTC)),
+		UserReleaseDate: "6/Jul/2010",
+		Overdue:         true,
+	}
+	assert.Equal(t, want, version)
+}
+
+func TestVersionsServiceListForProject(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project/MCP/versions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprintf(w, `[%s]`, versionJSON)
+	})
+
+	versions, _, err := client.Versions.ListForProject(context.Background(), "MCP")
+	assert.Nil(t, err)
+	assert.Len(t, versions, 1)
+	assert.Equal(t, 10000, versions[0].ID)
+}
+
+func TestVersionsServiceCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/version", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"name": "New Version 1", "project": "MCP", "startDate": "2010-07-01"}, body)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, versionJSON)
+	})
+
+	newVersion := &Version{
+		Name:      "New Version 1",
+		Project:   "MCP",
+		StartDate: NewDate(time.Date(2010, 7, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	version, _, err := client.Versions.Create(context.Background(), newVersion)
+	assert.Nil(t, err)
+	assert.Equal(t, 10000, version.ID)
+}
+
+func TestVersionsServiceUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var bodies []map[string]interface{}
+	mux.HandleFunc("/api/2/version/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		fmt.Fprint(w, versionJSON)
+	})
+
+	_, _, err := client.Versions.Update(context.Background(), 10000, &Version{ID: 10000, Description: "Better"})
+	assert.Nil(t, err)
+
+	_, _, err = client.Versions.Release(context.Background(), 10000, true, time.Date(2010, 7, 6, 12, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+
+	_, _, err = client.Versions.Release(context.Background(), 10000, false, time.Time{})
+	assert.Nil(t, err)
+
+	_, _, err = client.Versions.Archive(context.Background(), 10000, false)
+	assert.Nil(t, err)
+
+	want := []map[string]interface{}{
+		{"description": "Better"},
+		{"released": true, "releaseDate": "2010-07-06"},
+		{"released": false},
+		{"archived": false},
+	}
+	assert.Equal(t, want, bodies)
+}
+
+func TestVersionsServiceMergeMoveDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/version/10000/mergeto/10001", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("/api/2/version/10000/move", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		move := &VersionMove{}
+		json.NewDecoder(r.Body).Decode(move)
+		assert.Equal(t, VersionPositionFirst, move.Position)
+		fmt.Fprint(w, versionJSON)
+	})
+
+	mux.HandleFunc("/api/2/version/10002", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "10001", r.URL.Query().Get("moveFixIssuesTo"))
+		assert.Equal(t, "", r.URL.Query().Get("moveAffectedIssuesTo"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	merged, _, err := client.Versions.Merge(context.Background(), 10000, 10001)
+	assert.Nil(t, err)
+	assert.True(t, merged)
+
+	_, _, err = client.Versions.Move(context.Background(), 10000, &VersionMove{Position: VersionPositionFirst})
+	assert.Nil(t, err)
+
+	deleted, _, err := client.Versions.Delete(context.Background(), 10002, &DeleteVersionOptions{MoveFixIssuesTo: 10001})
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
+
+func TestVersionsServiceIssueCounts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/version/10000/relatedIssueCounts", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"self": "http://www.example.com/jira/rest/api/2/version/10000","issuesFixedCount": 23,"issuesAffectedCount": 101}`)
+	})
+
+	mux.HandleFunc("/api/2/version/10000/unresolvedIssueCount", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"self": "http://www.example.com/jira/rest/api/2/version/10000","issuesUnresolvedCount": 23}`)
+	})
+
+	counts, _, err := client.Versions.GetRelatedIssueCounts(context.Background(), 10000)
+	assert.Nil(t, err)
+	assert.Equal(t, 23, counts.FixedCount)
+	assert.Equal(t, 101, counts.AffectedCount)
+
+	unresolved, _, err := client.Versions.GetUnresolvedIssueCount(context.Background(), 10000)
+	assert.Nil(t, err)
+	assert.Equal(t, 23, unresolved.UnresolvedCount)
+}